@@ -67,6 +67,17 @@ type DingoCli struct {
 	monitor             storage.Monitor
 
 	dingoLogger *logger.DingoLogger
+
+	// audit log id of the running command, -1 if not audited
+	auditId int64
+
+	// playbook concurrency (dingo.yaml and flags), parsed by the playbook on demand
+	concurrency       *configure.ConcurrencyConfig
+	concurrencyLoader func() (*configure.ConcurrencyConfig, error)
+
+	// dry-run
+	recorder     *module.Recorder
+	dryRunFormat string
 }
 
 /*
@@ -91,6 +102,7 @@ func NewDingoCli() (*DingoCli, error) {
 		pluginDir: path.Join(rootDir, "plugins"),
 		logDir:    path.Join(rootDir, "logs"),
 		tempDir:   path.Join(rootDir, "temp"),
+		auditId:   -1,
 	}
 
 	err = dingocli.init()
@@ -280,6 +292,28 @@ func (dingocli *DingoCli) ClusterName() string               { return dingocli.c
 func (dingocli *DingoCli) ClusterTopologyData() string       { return dingocli.clusterTopologyData }
 func (dingocli *DingoCli) ClusterPoolData() string           { return dingocli.clusterPoolData }
 func (dingocli *DingoCli) Monitor() storage.Monitor          { return dingocli.monitor }
+func (dingocli *DingoCli) AuditId() int64                    { return dingocli.auditId }
+func (dingocli *DingoCli) Concurrency() *configure.ConcurrencyConfig {
+	return dingocli.concurrency
+}
+
+func (dingocli *DingoCli) SetConcurrencyLoader(loader func() (*configure.ConcurrencyConfig, error)) {
+	dingocli.concurrencyLoader = loader
+}
+
+// LoadConcurrency parses the playbook concurrency once, only the commands
+// which run playbook need it
+func (dingocli *DingoCli) LoadConcurrency() error {
+	if dingocli.concurrency != nil || dingocli.concurrencyLoader == nil {
+		return nil
+	}
+	concurrency, err := dingocli.concurrencyLoader()
+	if err != nil {
+		return err
+	}
+	dingocli.concurrency = concurrency
+	return nil
+}
 
 func (dingocli *DingoCli) GetHost(host string) (*hosts.HostConfig, error) {
 	if len(dingocli.Hosts()) == 0 {
@@ -420,7 +454,7 @@ func (dingocli *DingoCli) CheckRole(role string) error {
 	}
 
 	kind := dcs[0].GetKind()
-	roles := topology.DINGOFS_ROLES
+	roles := append([]string{topology.ROLE_FS_CACHE}, topology.DINGOFS_ROLES...)
 	switch kind {
 	case topology.KIND_DINGODB:
 		roles = topology.DINGODB_ROLES
@@ -506,6 +540,11 @@ func (dingocli *DingoCli) PreAudit(now time.Time, args []string) int64 {
 	} else if args[0] == "audit" || args[0] == "__complete" {
 		return -1
 	}
+	for _, arg := range args {
+		if arg == "--dry-run" || strings.HasPrefix(arg, "--dry-run=") {
+			return -1
+		}
+	}
 
 	cwd, _ := os.Getwd()
 	command := fmt.Sprintf("dingocli %s", strings.Join(args, " "))
@@ -514,8 +553,10 @@ func (dingocli *DingoCli) PreAudit(now time.Time, args []string) int64 {
 	if err != nil {
 		log.Error("Insert audit log failed",
 			log.Field("Error", err))
+		return -1
 	}
 
+	dingocli.auditId = id
 	return id
 }
 
@@ -554,6 +595,21 @@ func (dingocli *DingoCli) PostAudit(id int64, ec error) {
 	}
 }
 
+// RecordAudit records an operation which is a part of the running command,
+// e.g. every state change of a long running workflow
+func (dingocli *DingoCli) RecordAudit(ec error, format string, a ...interface{}) {
+	cwd, _ := os.Getwd()
+	command := fmt.Sprintf("dingocli %s", fmt.Sprintf(format, a...))
+	id, err := dingocli.Storage().InsertAuditLog(
+		time.Now(), cwd, command, comm.AUDIT_STATUS_ABORT)
+	if err != nil {
+		log.Error("Insert audit log failed",
+			log.Field("Error", err))
+		return
+	}
+	dingocli.PostAudit(id, ec)
+}
+
 func (dingocli *DingoCli) SwitchCluster(cluster storage.Cluster) error {
 
 	dingocli.memStorage = utils.NewSafeMap()
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/tui"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	DRY_RUN_FORMAT_TEXT = "text"
+	DRY_RUN_FORMAT_JSON = "json"
+)
+
+// SetDryRun makes playbook tasks record commands instead of executing them,
+// and the database is read-only during dry-run
+func (dingocli *DingoCli) SetDryRun(format string) error {
+	if format != DRY_RUN_FORMAT_TEXT && format != DRY_RUN_FORMAT_JSON {
+		return errno.ERR_UNSUPPORT_DRY_RUN_FORMAT.F("format: %s", format)
+	}
+
+	dingocli.recorder = module.NewRecorder()
+	dingocli.dryRunFormat = format
+	dingocli.storage.SetReadOnly(true)
+	return nil
+}
+
+func (dingocli *DingoCli) DryRun() bool               { return dingocli.recorder != nil }
+func (dingocli *DingoCli) Recorder() *module.Recorder { return dingocli.recorder }
+
+// PrintDryRunPlan prints all recorded commands grouped by step and host
+func (dingocli *DingoCli) PrintDryRunPlan() error {
+	if !dingocli.DryRun() {
+		return nil
+	}
+
+	records := dingocli.recorder.Records()
+	if dingocli.dryRunFormat == DRY_RUN_FORMAT_JSON {
+		data, err := json.MarshalIndent(records, "", "  ")
+		if err != nil {
+			return errno.ERR_PRINT_DRY_RUN_PLAN_FAILED.E(err)
+		}
+		dingocli.WriteOutln("%s", data)
+		return nil
+	}
+
+	dingocli.WriteOutln("")
+	if len(records) == 0 {
+		dingocli.WriteOutln("Dry run: nothing to execute")
+		return nil
+	}
+	dingocli.WriteOutln("Dry run plan:")
+	dingocli.WriteOutln("%s", tui.FormatDryRunPlan(records))
+	return nil
+}
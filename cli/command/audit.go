@@ -17,6 +17,8 @@
 package command
 
 import (
+	"strconv"
+
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/tui"
@@ -60,7 +62,17 @@ func runAudit(dingocli *cli.DingoCli, options auditOptions) error {
 	if tail != 0 && tail > 0 && tail < len(auditLogs) {
 		auditLogs = auditLogs[len(auditLogs)-tail:]
 	}
-	output := tui.FormatAuditLogs(auditLogs, options.verbose)
+	runs, err := dingocli.Storage().GetPlaybookRuns()
+	if err != nil {
+		return errno.ERR_SELECT_PLAYBOOK_RUN_FAILED.E(err)
+	}
+	runIds := map[int][]string{}
+	for _, run := range runs {
+		id := int(run.AuditId)
+		runIds[id] = append(runIds[id], strconv.FormatInt(run.Id, 10))
+	}
+
+	output := tui.FormatAuditLogs(auditLogs, runIds, options.verbose)
 	dingocli.WriteOut(output)
 	return nil
 }
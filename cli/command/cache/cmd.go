@@ -18,6 +18,7 @@ package cache
 
 import (
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/cache/disk"
 	"github.com/dingodb/dingocli/cli/command/cache/group"
 	"github.com/dingodb/dingocli/cli/command/cache/member"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
@@ -35,7 +36,11 @@ func NewCacheCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.AddCommand(
 		group.NewCacheGroupCommand(dingocli),
 		member.NewCacheMemberCommand(dingocli),
+		disk.NewCacheDiskCommand(dingocli),
 		NewCacheStartCommand(dingocli),
+		NewCacheStopCommand(dingocli),
+		NewCacheRestartCommand(dingocli),
+		NewCacheStatusCommand(dingocli),
 	)
 
 	return cmd
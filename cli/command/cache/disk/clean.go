@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package disk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHE_DISK_CLEAN_EXAMPLE = `Examples:
+   # remove the cached blocks of the client of mountpoint
+   $ dingo cache disk clean /mnt/dingofs
+
+   # remove the cached blocks not modified in 7 days on remote hosts
+   $ dingo cache disk clean --client-config client.yaml --hosts host1,host2 --older-than 168h`
+)
+
+type cleanOptions struct {
+	diskOptions
+	olderThan time.Duration
+}
+
+type cleanResult struct {
+	Host         string `json:"host"`
+	Dir          string `json:"dir"`
+	RemovedFiles int64  `json:"removed_files"`
+	RemovedBytes int64  `json:"removed_bytes"`
+	StageFiles   int64  `json:"stage_files"`
+	StageBytes   int64  `json:"stage_bytes"`
+	Error        string `json:"error,omitempty"`
+}
+
+func NewCacheDiskCleanCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options cleanOptions
+
+	cmd := &cobra.Command{
+		Use:     "clean [MOUNTPOINT] [OPTIONS]",
+		Short:   "Remove cached blocks of client disk cache, staged blocks are kept",
+		Args:    utils.RequiresMaxArgs(1),
+		Example: CACHE_DISK_CLEAN_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.mountpoint = args[0]
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+			return runClean(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	addDiskFlags(cmd, &options.diskOptions)
+	cmd.Flags().DurationVar(&options.olderThan, "older-than", 0, "Only remove blocks not modified in this duration")
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+// remove the cached blocks and print the size of each removed block,
+// the stage blocks which not uploaded yet never match the filter
+func cleanCommand(dir string, olderThan time.Duration) string {
+	filter := "-path '*/cache/*' -not -path '*/stage/*'"
+	if olderThan > 0 {
+		filter = fmt.Sprintf("%s -mmin +%d", filter, int(olderThan.Minutes()))
+	}
+	return fmt.Sprintf("cd %s && find . -type f %s -printf '%%s\\n' -delete", shellQuote(dir), filter)
+}
+
+func parseCleanOutput(result *cleanResult, out string) error {
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		size, err := strconv.ParseInt(line, 10, 64)
+		if err != nil {
+			return errno.ERR_CLEAN_DISK_CACHE_FAILED.F("invalid line: %s", line)
+		}
+		result.RemovedFiles++
+		result.RemovedBytes += size
+	}
+	return nil
+}
+
+func cleanDir(dingocli *cli.DingoCli, target *diskTarget, dir string, olderThan time.Duration) *cleanResult {
+	result := &cleanResult{Host: target.host, Dir: dir}
+	usage := collectUsage(dingocli, target, dir)
+	if len(usage.Error) > 0 {
+		result.Error = usage.Error
+		return result
+	}
+	result.StageFiles = usage.StageFiles
+	result.StageBytes = usage.StageBytes
+
+	out, err := target.execute(dingocli, cleanCommand(dir, olderThan))
+	if err != nil {
+		result.Error = lastLine(out, err)
+	} else if err := parseCleanOutput(result, out); err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// cleanError returns the error of clean results, ERR_OK if all succeeded
+func cleanError(results []*cleanResult) *errno.ErrorCode {
+	failed := 0
+	for _, result := range results {
+		if len(result.Error) > 0 {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errno.ERR_CLEAN_DISK_CACHE_FAILED.
+			F("%d of %d directory(s) failed", failed, len(results))
+	}
+	return errno.ERR_OK
+}
+
+func runClean(cmd *cobra.Command, dingocli *cli.DingoCli, options cleanOptions) error {
+	dirs, err := getCacheDirs(options.diskOptions)
+	if err != nil {
+		return err
+	}
+	targets, err := getTargets(dingocli, options.diskOptions)
+	if err != nil {
+		return err
+	}
+
+	results := []*cleanResult{}
+	for _, target := range targets {
+		for _, dir := range dirs {
+			results = append(results, cleanDir(dingocli, target, dir, options.olderThan))
+		}
+	}
+
+	cleanErr := cleanError(results)
+	if options.format == "json" {
+		if err := output.OutputJson(&common.OutputResult{Error: cleanErr, Result: results}); err != nil {
+			return err
+		} else if cleanErr.GetCode() != errno.ERR_OK.GetCode() {
+			return cleanErr
+		}
+		return nil
+	}
+
+	header := []string{
+		common.ROW_HOST, common.ROW_PATH, common.ROW_REMOVED, common.ROW_FREED,
+		common.ROW_STAGE_FILES, common.ROW_STAGE_SIZE, common.ROW_REASON,
+	}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	staged := int64(0)
+	for _, result := range results {
+		row := make(map[string]string)
+		row[common.ROW_HOST] = result.Host
+		row[common.ROW_PATH] = result.Dir
+		row[common.ROW_REMOVED] = fmt.Sprintf("%d", result.RemovedFiles)
+		row[common.ROW_FREED] = humanize.IBytes(uint64(result.RemovedBytes))
+		row[common.ROW_STAGE_FILES] = fmt.Sprintf("%d", result.StageFiles)
+		row[common.ROW_STAGE_SIZE] = humanize.IBytes(uint64(result.StageBytes))
+		row[common.ROW_REASON] = utils.Choose(len(result.Error) > 0, result.Error, common.ROW_VALUE_NO_VALUE)
+		rows = append(rows, row)
+		staged += result.StageFiles
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_HOST, common.ROW_PATH})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no disk cache")
+
+	if staged > 0 {
+		dingocli.WriteOutln("%d staged block(s) are not uploaded yet and were kept", staged)
+	}
+	if cleanErr.GetCode() != errno.ERR_OK.GetCode() {
+		return cleanErr
+	}
+	return nil
+}
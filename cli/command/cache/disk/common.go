@@ -0,0 +1,283 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package disk
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/configure"
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/pkg/module"
+	"github.com/spf13/cobra"
+)
+
+const (
+	LOCAL_HOST = "localhost"
+
+	// layout of disk cache: <cache_dir>/<uuid>/{cache,stage}/blocks/...
+	BLOCK_TYPE_CACHE = "cache"
+	BLOCK_TYPE_STAGE = "stage"
+	BLOCK_TYPE_OTHER = "other"
+)
+
+type diskOptions struct {
+	mountpoint   string
+	clientConfig string
+	cacheDirs    []string
+	hosts        []string
+	labels       []string
+	format       string
+}
+
+// disk cache usage of one directory on one host
+type diskUsage struct {
+	Host       string    `json:"host"`
+	Dir        string    `json:"dir"`
+	Files      int64     `json:"files"`
+	Bytes      int64     `json:"bytes"`
+	CacheFiles int64     `json:"cache_files"`
+	CacheBytes int64     `json:"cache_bytes"`
+	StageFiles int64     `json:"stage_files"`
+	StageBytes int64     `json:"stage_bytes"`
+	Oldest     time.Time `json:"oldest"`
+	Newest     time.Time `json:"newest"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// the target hosts, nil host config means local host
+type diskTarget struct {
+	host string
+	hc   *hosts.HostConfig
+}
+
+func addDiskFlags(cmd *cobra.Command, options *diskOptions) {
+	flags := cmd.Flags()
+	flags.StringVar(&options.clientConfig, "client-config", "", "Read cache directories from client configure file")
+	flags.StringSliceVar(&options.cacheDirs, "cache-dir", []string{}, "Specify cache directories")
+	flags.StringSliceVar(&options.hosts, "hosts", []string{}, "Inspect the disk cache on these hosts over SSH")
+	flags.StringSliceVarP(&options.labels, "labels", "l", []string{}, "Inspect the disk cache on hosts which match the labels")
+}
+
+// classify the block by its path relative to cache directory
+func classifyBlock(rel string) string {
+	components := strings.Split(filepath.ToSlash(rel), "/")
+	for _, component := range components[:len(components)-1] {
+		if component == BLOCK_TYPE_STAGE {
+			return BLOCK_TYPE_STAGE
+		}
+	}
+	for _, component := range components[:len(components)-1] {
+		if component == BLOCK_TYPE_CACHE {
+			return BLOCK_TYPE_CACHE
+		}
+	}
+	return BLOCK_TYPE_OTHER
+}
+
+// cache directories from the flag, client configure or the client process of mountpoint
+func getCacheDirs(options diskOptions) ([]string, error) {
+	if len(options.cacheDirs) > 0 {
+		return options.cacheDirs, nil
+	}
+
+	if len(options.clientConfig) > 0 {
+		cc, err := configure.ParseClientConfig(options.clientConfig, "")
+		if err != nil {
+			// configure of mds v2 client
+			cc, err = configure.ParseClientConfig(options.clientConfig, configure.FS_TYPE_VKS_V2)
+		}
+		if err != nil {
+			return nil, err
+		}
+		dirs := cc.GetDiskCacheDirs()
+		if len(dirs) == 0 {
+			return nil, errno.ERR_DISK_CACHE_DIRECTORY_NOT_FOUND.
+				F("%s not set in %s", configure.KEY_DISK_CACHE_CACHE_DIR, options.clientConfig)
+		}
+		return dirs, nil
+	}
+
+	if len(options.mountpoint) > 0 {
+		if len(options.hosts) > 0 || len(options.labels) > 0 {
+			return nil, errno.ERR_DISK_CACHE_DIRECTORY_NOT_FOUND.
+				S("mountpoint only works on local host, specify --client-config or --cache-dir for remote hosts")
+		}
+		return findCacheDirsByMountpoint("/proc", options.mountpoint)
+	}
+
+	return nil, errno.ERR_DISK_CACHE_DIRECTORY_NOT_FOUND.
+		S("specify a mountpoint, --client-config or --cache-dir")
+}
+
+// find the client process serving the mountpoint and take its cache_dir flag
+func findCacheDirsByMountpoint(procRoot, mountpoint string) ([]string, error) {
+	mountpoint = filepath.Clean(mountpoint)
+	cmdlines, _ := filepath.Glob(filepath.Join(procRoot, "[0-9]*", "cmdline"))
+	for _, cmdline := range cmdlines {
+		data, err := os.ReadFile(cmdline)
+		if err != nil || len(data) == 0 {
+			continue
+		}
+
+		args := strings.Split(strings.TrimRight(string(data), "\x00"), "\x00")
+		matched := false
+		value := ""
+		for _, arg := range args {
+			if filepath.Clean(arg) == mountpoint {
+				matched = true
+			}
+			name, v, ok := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+			if ok && (name == "cache_dir" || name == configure.KEY_DISK_CACHE_CACHE_DIR) {
+				value = v
+			}
+		}
+		if matched && len(value) > 0 {
+			return configure.ParseDiskCacheDirs(value), nil
+		}
+	}
+
+	return nil, errno.ERR_DISK_CACHE_DIRECTORY_NOT_FOUND.
+		F("no client with cache_dir found for mountpoint %s", mountpoint)
+}
+
+func getTargets(dingocli *cli.DingoCli, options diskOptions) ([]*diskTarget, error) {
+	if len(options.hosts) == 0 && len(options.labels) == 0 {
+		return []*diskTarget{{host: LOCAL_HOST}}, nil
+	}
+
+	hcs := []*hosts.HostConfig{}
+	if len(options.hosts) > 0 {
+		for _, name := range options.hosts {
+			hc, err := dingocli.GetHost(name)
+			if err != nil {
+				return nil, err
+			}
+			hcs = append(hcs, hc)
+		}
+	} else {
+		var err error
+		hcs, err = hosts.Filter(dingocli.Hosts(), options.labels)
+		if err != nil {
+			return nil, err
+		} else if len(hcs) == 0 {
+			return nil, errno.ERR_HOST_NOT_FOUND.F("labels: %s", strings.Join(options.labels, ","))
+		}
+	}
+
+	targets := []*diskTarget{}
+	for _, hc := range hcs {
+		targets = append(targets, &diskTarget{host: hc.GetHost(), hc: hc})
+	}
+	return targets, nil
+}
+
+func (t *diskTarget) execute(dingocli *cli.DingoCli, command string) (string, error) {
+	if t.hc == nil {
+		options := dingocli.ExecOptions()
+		options.ExecInLocal = true
+		options.ExecWithSudo = false
+		return module.NewShell(nil).Command(command).Execute(options)
+	}
+
+	sshClient, err := module.NewSSHClient(*t.hc.GetSSHConfig())
+	if err != nil {
+		return "", errno.ERR_SSH_CONNECT_FAILED.E(err)
+	}
+	defer sshClient.Client().Close()
+
+	return module.NewModule(sshClient).Shell().Command(command).Execute(dingocli.ExecOptions())
+}
+
+// list all files under the cache directory, one "<mtime> <size> <relative path>" per line
+func listCommand(dir string) string {
+	return fmt.Sprintf("cd %s && find . -type f -printf '%%T@ %%s %%P\\n'", shellQuote(dir))
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// aggregate the output of listCommand
+func aggregateUsage(host, dir, out string) (*diskUsage, error) {
+	usage := &diskUsage{Host: host, Dir: dir}
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) != 3 {
+			return nil, errno.ERR_COLLECT_DISK_CACHE_FAILED.F("invalid line: %s", line)
+		}
+		mtime, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return nil, errno.ERR_COLLECT_DISK_CACHE_FAILED.F("invalid mtime: %s", line)
+		}
+		size, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, errno.ERR_COLLECT_DISK_CACHE_FAILED.F("invalid size: %s", line)
+		}
+
+		usage.Files++
+		usage.Bytes += size
+		switch classifyBlock(fields[2]) {
+		case BLOCK_TYPE_CACHE:
+			usage.CacheFiles++
+			usage.CacheBytes += size
+		case BLOCK_TYPE_STAGE:
+			usage.StageFiles++
+			usage.StageBytes += size
+		}
+
+		t := time.Unix(0, int64(mtime*float64(time.Second)))
+		if usage.Oldest.IsZero() || t.Before(usage.Oldest) {
+			usage.Oldest = t
+		}
+		if t.After(usage.Newest) {
+			usage.Newest = t
+		}
+	}
+	return usage, nil
+}
+
+func collectUsage(dingocli *cli.DingoCli, target *diskTarget, dir string) *diskUsage {
+	out, err := target.execute(dingocli, listCommand(dir))
+	if err != nil {
+		return &diskUsage{Host: target.host, Dir: dir, Error: lastLine(out, err)}
+	}
+	usage, err := aggregateUsage(target.host, dir, out)
+	if err != nil {
+		return &diskUsage{Host: target.host, Dir: dir, Error: err.Error()}
+	}
+	return usage
+}
+
+func lastLine(out string, err error) string {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if line := strings.TrimSpace(lines[len(lines)-1]); len(line) > 0 {
+		return line
+	}
+	return err.Error()
+}
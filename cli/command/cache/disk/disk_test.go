@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/configure"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDiskCacheDirs(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{}, configure.ParseDiskCacheDirs(""))
+	assert.Equal([]string{"/data1/cache", "/data2/cache"},
+		configure.ParseDiskCacheDirs("/data1/cache:10240; /data2/cache"))
+	assert.Equal([]string{"/data/a:b"}, configure.ParseDiskCacheDirs("/data/a:b"))
+}
+
+func TestClassifyBlock(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(BLOCK_TYPE_CACHE, classifyBlock("uuid/cache/blocks/0/1_0_4194304"))
+	assert.Equal(BLOCK_TYPE_STAGE, classifyBlock("uuid/stage/blocks/0/1_0_4194304"))
+	assert.Equal(BLOCK_TYPE_STAGE, classifyBlock("cache/stage/1"))
+	assert.Equal(BLOCK_TYPE_OTHER, classifyBlock("uuid/.probe"))
+	assert.Equal(BLOCK_TYPE_OTHER, classifyBlock("cache"))
+}
+
+func TestAggregateUsage(t *testing.T) {
+	assert := assert.New(t)
+	out := "1700000100.5 100 u/cache/blocks/1\n" +
+		"1700000000.0 300 u/stage/blocks/2\n" +
+		"\n" +
+		"1700000200.0 10 u/lock\n"
+	usage, err := aggregateUsage("host1", "/data/cache", out)
+	assert.NoError(err)
+	assert.Equal(int64(3), usage.Files)
+	assert.Equal(int64(410), usage.Bytes)
+	assert.Equal(int64(1), usage.CacheFiles)
+	assert.Equal(int64(100), usage.CacheBytes)
+	assert.Equal(int64(1), usage.StageFiles)
+	assert.Equal(int64(300), usage.StageBytes)
+	assert.Equal(time.Unix(1700000000, 0), usage.Oldest)
+	assert.Equal(time.Unix(1700000200, 0), usage.Newest)
+
+	_, err = aggregateUsage("host1", "/data/cache", "abc 1 u/cache/1")
+	assert.Error(err)
+	_, err = aggregateUsage("host1", "/data/cache", "1700000000")
+	assert.Error(err)
+}
+
+func TestFindCacheDirsByMountpoint(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	writeCmdline := func(pid string, args ...string) {
+		os.MkdirAll(filepath.Join(root, pid), 0755)
+		data := ""
+		for _, arg := range args {
+			data += arg + "\x00"
+		}
+		os.WriteFile(filepath.Join(root, pid, "cmdline"), []byte(data), 0644)
+	}
+	writeCmdline("100", "dingo-client", "--cache_dir=/data1/cache:1024;/data2/cache", "/mnt/other")
+	writeCmdline("200", "dingo-client", "--conf=client.conf", "--cache_dir=/data3/cache", "/mnt/dingofs")
+
+	dirs, err := findCacheDirsByMountpoint(root, "/mnt/dingofs/")
+	assert.NoError(err)
+	assert.Equal([]string{"/data3/cache"}, dirs)
+
+	dirs, err = findCacheDirsByMountpoint(root, "/mnt/other")
+	assert.NoError(err)
+	assert.Equal([]string{"/data1/cache", "/data2/cache"}, dirs)
+
+	_, err = findCacheDirsByMountpoint(root, "/mnt/none")
+	assert.Error(err)
+}
+
+func TestCleanCommand(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("cd '/data/cache' && find . -type f -path '*/cache/*' -not -path '*/stage/*' -printf '%s\\n' -delete",
+		cleanCommand("/data/cache", 0))
+	assert.Contains(cleanCommand("/data/cache", 2*time.Hour), "-mmin +120")
+	assert.Equal(`'it'\''s'`, shellQuote("it's"))
+
+	result := &cleanResult{}
+	assert.NoError(parseCleanOutput(result, "100\n\n200\n"))
+	assert.Equal(int64(2), result.RemovedFiles)
+	assert.Equal(int64(300), result.RemovedBytes)
+	assert.Error(parseCleanOutput(result, "x"))
+}
+
+func TestCleanError(t *testing.T) {
+	assert := assert.New(t)
+
+	results := []*cleanResult{{Dir: "/data/cache1"}, {Dir: "/data/cache2"}}
+	assert.Equal(errno.ERR_OK.GetCode(), cleanError(results).GetCode())
+
+	results[1].Error = "permission denied"
+	err := cleanError(results)
+	assert.Equal(errno.ERR_CLEAN_DISK_CACHE_FAILED.GetCode(), err.GetCode())
+	assert.Equal("1 of 2 directory(s) failed", err.GetClue())
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package disk
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHE_DISK_STATUS_EXAMPLE = `Examples:
+   # show the disk cache used by the client of mountpoint
+   $ dingo cache disk status /mnt/dingofs
+
+   # show the disk cache of client configure on remote hosts
+   $ dingo cache disk status --client-config client.yaml --hosts host1,host2`
+)
+
+func NewCacheDiskStatusCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options diskOptions
+
+	cmd := &cobra.Command{
+		Use:     "status [MOUNTPOINT] [OPTIONS]",
+		Short:   "Show usage and stage backlog of client disk cache",
+		Args:    utils.RequiresMaxArgs(1),
+		Example: CACHE_DISK_STATUS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.mountpoint = args[0]
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+			return runStatus(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	addDiskFlags(cmd, &options)
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runStatus(cmd *cobra.Command, dingocli *cli.DingoCli, options diskOptions) error {
+	dirs, err := getCacheDirs(options)
+	if err != nil {
+		return err
+	}
+	targets, err := getTargets(dingocli, options)
+	if err != nil {
+		return err
+	}
+
+	usages := []*diskUsage{}
+	for _, target := range targets {
+		for _, dir := range dirs {
+			usages = append(usages, collectUsage(dingocli, target, dir))
+		}
+	}
+
+	if options.format == "json" {
+		return output.OutputJson(&common.OutputResult{Error: errno.ERR_OK, Result: usages})
+	}
+
+	header := []string{
+		common.ROW_HOST, common.ROW_PATH, common.ROW_FILES, common.ROW_SIZE, common.ROW_STAGE_FILES,
+		common.ROW_STAGE_SIZE, common.ROW_OLDEST, common.ROW_NEWEST, common.ROW_REASON,
+	}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, usage := range usages {
+		row := make(map[string]string)
+		row[common.ROW_HOST] = usage.Host
+		row[common.ROW_PATH] = usage.Dir
+		row[common.ROW_FILES] = fmt.Sprintf("%d", usage.Files)
+		row[common.ROW_SIZE] = humanize.IBytes(uint64(usage.Bytes))
+		row[common.ROW_STAGE_FILES] = fmt.Sprintf("%d", usage.StageFiles)
+		row[common.ROW_STAGE_SIZE] = humanize.IBytes(uint64(usage.StageBytes))
+		row[common.ROW_OLDEST] = formatTime(usage.Oldest)
+		row[common.ROW_NEWEST] = formatTime(usage.Newest)
+		row[common.ROW_REASON] = utils.Choose(len(usage.Error) > 0, usage.Error, common.ROW_VALUE_NO_VALUE)
+		rows = append(rows, row)
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_HOST, common.ROW_PATH})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no disk cache")
+
+	return nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return common.ROW_VALUE_NO_VALUE
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
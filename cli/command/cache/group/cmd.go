@@ -31,6 +31,7 @@ func NewCacheGroupCommand(dingocli *cli.DingoCli) *cobra.Command {
 
 	cmd.AddCommand(
 		NewCacheGroupListCommand(dingocli),
+		NewCacheGroupRebalanceCommand(dingocli),
 	)
 
 	return cmd
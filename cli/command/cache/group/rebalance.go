@@ -0,0 +1,397 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package group
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	CACHEGROUP_REBALANCE_EXAMPLE = `Examples:
+   # show the planned weights which are proportional to the capacity of members
+   $ dingo cache group rebalance --group group1 --dry-run
+
+   # weight by free space, 2 members per batch
+   $ dingo cache group rebalance --group group1 --by free --batch-size 2
+
+   # capacity of members which export no metrics are read from manifest
+   $ dingo cache group rebalance --group group1 --manifest capacity.yaml`
+
+	REBALANCE_BY_CAPACITY = "capacity"
+	REBALANCE_BY_FREE     = "free"
+
+	// members keep their weight during rebalance
+	SKIP_REASON_DRAINING   = "draining"
+	SKIP_REASON_NOT_ONLINE = "not online"
+)
+
+type rebalanceOptions struct {
+	group          string
+	manifest       string
+	capacityMetric string
+	usedMetric     string
+	by             string
+	maxWeight      uint32
+	batchSize      int
+	interval       time.Duration
+	dryRun         bool
+	noConfirm      bool
+	format         string
+}
+
+// capacity of one member in manifest, e.g.
+//
+//	members:
+//	  - id: 85a4b352-4097-4868-9cd6-9ec5e53db1b6
+//	    capacity: 2TiB
+//	    used: 512GiB
+//	  - ip: 10.220.69.6
+//	    port: 10001
+//	    capacity: 8TiB
+type manifestMember struct {
+	Id       string `mapstructure:"id"`
+	Ip       string `mapstructure:"ip"`
+	Port     uint32 `mapstructure:"port"`
+	Capacity string `mapstructure:"capacity"`
+	Used     string `mapstructure:"used"`
+}
+
+type memberCapacity struct {
+	MemberId  string `json:"member_id"`
+	Ip        string `json:"ip"`
+	Port      uint32 `json:"port"`
+	State     string `json:"state"`
+	Capacity  uint64 `json:"capacity"`
+	Used      uint64 `json:"used"`
+	Weight    uint32 `json:"weight"`
+	NewWeight uint32 `json:"new_weight"`
+	Skipped   string `json:"skipped,omitempty"`
+}
+
+func NewCacheGroupRebalanceCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options rebalanceOptions
+
+	cmd := &cobra.Command{
+		Use:     "rebalance [OPTIONS]",
+		Short:   "Set weights of cache members in proportion to their capacity",
+		Args:    utils.NoArgs,
+		Example: CACHEGROUP_REBALANCE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.group = utils.GetStringFlag(cmd, utils.DINGOFS_CACHE_GROUP)
+			options.noConfirm = utils.GetBoolFlag(cmd, utils.DINGOFS_NOCONFIRM)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+			if options.by != REBALANCE_BY_CAPACITY && options.by != REBALANCE_BY_FREE {
+				return fmt.Errorf("--by must be %s or %s", REBALANCE_BY_CAPACITY, REBALANCE_BY_FREE)
+			} else if options.maxWeight == 0 {
+				return fmt.Errorf("--max-weight must be greater than 0")
+			} else if options.batchSize <= 0 {
+				return fmt.Errorf("--batch-size must be greater than 0")
+			}
+
+			options.dryRun = dingocli.DryRun()
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runRebalance(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddStringRequiredFlag(cmd, utils.DINGOFS_CACHE_GROUP, "Cache group name")
+	flags := cmd.Flags()
+	flags.StringVar(&options.manifest, "manifest", "", "Yaml(json) file which specify capacity and usage of members")
+	flags.StringVar(&options.capacityMetric, "capacity-metric", "dingofs_disk_cache_capacity_bytes", "Metric of member which reports its cache capacity")
+	flags.StringVar(&options.usedMetric, "used-metric", "dingofs_disk_cache_used_bytes", "Metric of member which reports its used cache bytes")
+	flags.StringVar(&options.by, "by", REBALANCE_BY_CAPACITY, "Weight members by capacity or free space (capacity|free)")
+	flags.Uint32Var(&options.maxWeight, "max-weight", 100, "Weight of the largest member")
+	flags.IntVar(&options.batchSize, "batch-size", 1, "Number of members to reweight in one batch")
+	flags.DurationVar(&options.interval, "interval", 10*time.Second, "Interval between two batches")
+
+	utils.AddBoolFlag(cmd, utils.DINGOFS_NOCONFIRM, "Do not confirm the command")
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+	utils.SupportDryRun(cmd)
+
+	return cmd
+}
+
+func parseManifest(filename string) ([]manifestMember, error) {
+	parser := viper.New()
+	parser.SetConfigFile(filename)
+	if err := parser.ReadInConfig(); err != nil {
+		return nil, errno.ERR_PARSE_CACHE_MANIFEST_FAILED.E(err)
+	}
+
+	members := []manifestMember{}
+	if err := parser.UnmarshalKey("members", &members); err != nil {
+		return nil, errno.ERR_PARSE_CACHE_MANIFEST_FAILED.E(err)
+	}
+	return members, nil
+}
+
+// fill capacity and usage of member from manifest, match by member id or address
+func fillFromManifest(member *memberCapacity, manifest []manifestMember) (bool, error) {
+	for _, item := range manifest {
+		if item.Id != member.MemberId && (item.Ip != member.Ip || item.Port != member.Port) {
+			continue
+		}
+
+		capacity, err := humanize.ParseBytes(item.Capacity)
+		if err != nil {
+			return false, errno.ERR_PARSE_CACHE_MANIFEST_FAILED.
+				F("invalid capacity of member %s: %s", member.MemberId, item.Capacity)
+		}
+		used := uint64(0)
+		if len(item.Used) > 0 {
+			if used, err = humanize.ParseBytes(item.Used); err != nil {
+				return false, errno.ERR_PARSE_CACHE_MANIFEST_FAILED.
+					F("invalid used of member %s: %s", member.MemberId, item.Used)
+			}
+		}
+		member.Capacity, member.Used = capacity, used
+		return true, nil
+	}
+	return false, nil
+}
+
+func fillFromMetric(member *memberCapacity, options rebalanceOptions) error {
+	capacity, err := utils.GetMetricValue(member.Ip, member.Port, options.capacityMetric)
+	if err != nil {
+		return err
+	}
+	used, err := utils.GetMetricValue(member.Ip, member.Port, options.usedMetric)
+	if err != nil {
+		return err
+	}
+	member.Capacity, member.Used = uint64(capacity), uint64(used)
+	return nil
+}
+
+// planWeights sets weights in proportion to capacity (or free space) of members,
+// the largest one gets maxWeight and every member gets at least 1, the skipped
+// members keep their weight
+func planWeights(members []*memberCapacity, by string, maxWeight uint32) error {
+	basis := func(member *memberCapacity) uint64 {
+		if by == REBALANCE_BY_FREE {
+			if member.Used >= member.Capacity {
+				return 0
+			}
+			return member.Capacity - member.Used
+		}
+		return member.Capacity
+	}
+
+	max, candidates := uint64(0), 0
+	for _, member := range members {
+		member.NewWeight = member.Weight
+		if len(member.Skipped) > 0 {
+			continue
+		}
+		candidates++
+		if basis(member) > max {
+			max = basis(member)
+		}
+	}
+	if candidates == 0 {
+		return nil
+	} else if max == 0 {
+		return errno.ERR_CACHE_MEMBER_CAPACITY_UNKNOWN.F("all members have no %s", by)
+	}
+
+	for _, member := range members {
+		if len(member.Skipped) > 0 {
+			continue
+		}
+		weight := math.Round(float64(basis(member)) * float64(maxWeight) / float64(max))
+		member.NewWeight = uint32(math.Max(weight, 1))
+	}
+	return nil
+}
+
+// skipReason returns why the member should keep its weight, members which
+// are draining or not online are excluded from rebalance
+func skipReason(dingocli *cli.DingoCli, member *memberCapacity) (string, error) {
+	items, err := dingocli.Storage().GetCacheMemberDrain(member.MemberId)
+	if err != nil {
+		return "", errno.ERR_SELECT_CACHE_MEMBER_DRAIN_FAILED.E(err)
+	} else if len(items) > 0 {
+		return SKIP_REASON_DRAINING, nil
+	} else if member.State != "online" {
+		return SKIP_REASON_NOT_ONLINE, nil
+	}
+	return "", nil
+}
+
+func collectCapacity(cmd *cobra.Command, dingocli *cli.DingoCli, options rebalanceOptions) ([]*memberCapacity, error) {
+	manifest := []manifestMember{}
+	if len(options.manifest) > 0 {
+		var err error
+		if manifest, err = parseManifest(options.manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := rpc.ListCacheMembers(cmd, options.group)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []*memberCapacity{}
+	for _, item := range items {
+		member := &memberCapacity{
+			MemberId: item.GetMemberId(),
+			Ip:       item.GetIp(),
+			Port:     item.GetPort(),
+			State:    utils.TranslateCacheGroupMemberState(item.GetState()),
+			Weight:   item.GetWeight(),
+		}
+		if member.Skipped, err = skipReason(dingocli, member); err != nil {
+			return nil, err
+		} else if len(member.Skipped) > 0 {
+			members = append(members, member)
+			continue
+		}
+
+		found, err := fillFromManifest(member, manifest)
+		if err != nil {
+			return nil, err
+		} else if !found {
+			if err := fillFromMetric(member, options); err != nil {
+				logger.Warnf("get capacity of cache member %s failed: %v", member.MemberId, err)
+				return nil, errno.ERR_CACHE_MEMBER_CAPACITY_UNKNOWN.
+					F("memberid: %s, please specify it in --manifest", member.MemberId)
+			}
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+func displayPlan(members []*memberCapacity) {
+	header := []string{
+		common.ROW_MEMBERID, common.ROW_IP, common.ROW_PORT, common.ROW_STATE,
+		common.ROW_CAPACITY, common.ROW_USED, common.ROW_WEIGHT, common.ROW_NEW_WEIGHT,
+	}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, member := range members {
+		row := make(map[string]string)
+		row[common.ROW_MEMBERID] = member.MemberId
+		row[common.ROW_IP] = member.Ip
+		row[common.ROW_PORT] = fmt.Sprintf("%d", member.Port)
+		row[common.ROW_STATE] = member.State
+		row[common.ROW_CAPACITY] = humanize.IBytes(member.Capacity)
+		row[common.ROW_USED] = humanize.IBytes(member.Used)
+		row[common.ROW_WEIGHT] = fmt.Sprintf("%d", member.Weight)
+		row[common.ROW_NEW_WEIGHT] = utils.Choose(member.NewWeight == member.Weight,
+			common.ROW_VALUE_NO_VALUE, fmt.Sprintf("%d", member.NewWeight))
+		if len(member.Skipped) > 0 {
+			row[common.ROW_NEW_WEIGHT] = fmt.Sprintf("skipped (%s)", member.Skipped)
+		}
+		rows = append(rows, row)
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_IP, common.ROW_PORT})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no cachemember in group")
+}
+
+func runRebalance(cmd *cobra.Command, dingocli *cli.DingoCli, options rebalanceOptions) error {
+	members, err := collectCapacity(cmd, dingocli, options)
+	if err != nil {
+		return err
+	}
+	if len(members) > 0 {
+		if err := planWeights(members, options.by, options.maxWeight); err != nil {
+			return err
+		}
+	}
+
+	changes := []*memberCapacity{}
+	for _, member := range members {
+		if member.NewWeight != member.Weight {
+			changes = append(changes, member)
+		}
+	}
+
+	// show the plan
+	if options.format == "json" {
+		if err := output.OutputJson(&common.OutputResult{Error: errno.ERR_OK, Result: members}); err != nil {
+			return err
+		}
+	} else {
+		displayPlan(members)
+	}
+	if options.dryRun || len(changes) == 0 {
+		return nil
+	}
+
+	if !options.noConfirm && !utils.AskConfirmation(
+		fmt.Sprintf("Are you sure to reweight %d cachemember(s) in group %s?", len(changes), options.group),
+		options.group) {
+		return errno.ERR_CANCEL_OPERATION
+	}
+
+	// apply in batches
+	for start := 0; start < len(changes); start += options.batchSize {
+		if start > 0 {
+			time.Sleep(options.interval)
+		}
+		end := start + options.batchSize
+		if end > len(changes) {
+			end = len(changes)
+		}
+		for _, member := range changes[start:end] {
+			err := rpc.ReweightCacheMember(cmd, member.MemberId, member.Ip, member.Port, member.NewWeight)
+			dingocli.RecordAudit(err, "cache group rebalance --group %s: reweight %s %d -> %d",
+				options.group, member.MemberId, member.Weight, member.NewWeight)
+			if err != nil {
+				return err
+			}
+			dingocli.WriteOutln("Reweight cache member %s: %d -> %d", member.MemberId, member.Weight, member.NewWeight)
+		}
+	}
+
+	dingocli.WriteOutln("Successfully rebalance cachegroup %s", options.group)
+	return nil
+}
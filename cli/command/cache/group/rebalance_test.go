@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package group
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	TiB = uint64(1) << 40
+	GiB = uint64(1) << 30
+)
+
+func TestPlanWeightsByCapacity(t *testing.T) {
+	assert := assert.New(t)
+
+	members := []*memberCapacity{
+		{MemberId: "m1", Capacity: 2 * TiB},
+		{MemberId: "m2", Capacity: 8 * TiB},
+		{MemberId: "m3", Capacity: 4 * TiB, Used: 4 * TiB},
+	}
+	assert.NoError(planWeights(members, REBALANCE_BY_CAPACITY, 100))
+	assert.Equal(uint32(25), members[0].NewWeight)
+	assert.Equal(uint32(100), members[1].NewWeight)
+	assert.Equal(uint32(50), members[2].NewWeight)
+}
+
+func TestPlanWeightsByFree(t *testing.T) {
+	assert := assert.New(t)
+
+	members := []*memberCapacity{
+		{MemberId: "m1", Capacity: 2 * TiB, Used: 1 * TiB},
+		{MemberId: "m2", Capacity: 8 * TiB, Used: 4 * TiB},
+		{MemberId: "m3", Capacity: 4 * TiB, Used: 4 * TiB},
+	}
+	assert.NoError(planWeights(members, REBALANCE_BY_FREE, 100))
+	assert.Equal(uint32(25), members[0].NewWeight)
+	assert.Equal(uint32(100), members[1].NewWeight)
+	assert.Equal(uint32(1), members[2].NewWeight) // at least 1
+
+	members = []*memberCapacity{{MemberId: "m1"}}
+	assert.Error(planWeights(members, REBALANCE_BY_CAPACITY, 100))
+}
+
+func TestPlanWeightsSkipped(t *testing.T) {
+	assert := assert.New(t)
+
+	members := []*memberCapacity{
+		{MemberId: "m1", Capacity: 2 * TiB, Weight: 100},
+		{MemberId: "m2", Capacity: 4 * TiB, Weight: 100},
+		{MemberId: "m3", Capacity: 8 * TiB, Weight: 30, Skipped: SKIP_REASON_DRAINING},
+		{MemberId: "m4", Weight: 100, Skipped: SKIP_REASON_NOT_ONLINE},
+	}
+	assert.NoError(planWeights(members, REBALANCE_BY_CAPACITY, 100))
+	assert.Equal(uint32(50), members[0].NewWeight)
+	assert.Equal(uint32(100), members[1].NewWeight)
+	assert.Equal(uint32(30), members[2].NewWeight) // keep the weight
+	assert.Equal(uint32(100), members[3].NewWeight)
+
+	// nothing to rebalance if all members are skipped
+	members = []*memberCapacity{{MemberId: "m1", Weight: 10, Skipped: SKIP_REASON_DRAINING}}
+	assert.NoError(planWeights(members, REBALANCE_BY_CAPACITY, 100))
+	assert.Equal(uint32(10), members[0].NewWeight)
+}
+
+func TestFillFromManifest(t *testing.T) {
+	assert := assert.New(t)
+
+	filename := filepath.Join(t.TempDir(), "capacity.yaml")
+	content := `
+members:
+  - id: m1
+    capacity: 2TiB
+    used: 512GiB
+  - ip: 10.220.69.6
+    port: 10001
+    capacity: 8TiB
+`
+	assert.NoError(os.WriteFile(filename, []byte(content), 0644))
+	manifest, err := parseManifest(filename)
+	assert.NoError(err)
+	assert.Len(manifest, 2)
+
+	member := &memberCapacity{MemberId: "m1"}
+	found, err := fillFromManifest(member, manifest)
+	assert.NoError(err)
+	assert.True(found)
+	assert.Equal(2*TiB, member.Capacity)
+	assert.Equal(512*GiB, member.Used)
+
+	member = &memberCapacity{MemberId: "m2", Ip: "10.220.69.6", Port: 10001}
+	found, err = fillFromManifest(member, manifest)
+	assert.NoError(err)
+	assert.True(found)
+	assert.Equal(8*TiB, member.Capacity)
+
+	member = &memberCapacity{MemberId: "m3", Ip: "10.220.69.7", Port: 10001}
+	found, err = fillFromManifest(member, manifest)
+	assert.NoError(err)
+	assert.False(found)
+}
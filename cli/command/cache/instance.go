@@ -0,0 +1,296 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	compmgr "github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/storage"
+)
+
+const (
+	CACHE_CLIENT_KIND  = compmgr.DINGO_DACHE
+	CACHE_CLIENT_HOST  = "localhost"
+	DEFAULT_CACHE_ID   = "default"
+	CACHE_START_WAIT   = 2 * time.Second
+	CACHE_STOP_TIMEOUT = 30 * time.Second
+)
+
+// cacheInstance is saved as aux info of the clients table, so the instance
+// can be stopped, restarted and shown after the starting command exited
+type cacheInstance struct {
+	Id        string    `json:"id"`
+	Binary    string    `json:"binary"`
+	Version   string    `json:"version"`
+	Args      []string  `json:"args"`
+	Listen    string    `json:"listen"`
+	Pid       int       `json:"pid"`       // pid in pidfile, the supervisor if supervised
+	ChildPid  int       `json:"child_pid"` // pid of dingo-cache if supervised
+	Restarts  int       `json:"restarts"`
+	Supervise bool      `json:"supervise"`
+	StartTime time.Time `json:"start_time"`
+}
+
+// getFlagValue returns the value of gflags style argument, e.g. --id=ID or --id ID
+func getFlagValue(args []string, name string) string {
+	for i, arg := range args {
+		for _, prefix := range []string{"--", "-"} {
+			if arg == prefix+name && i+1 < len(args) {
+				return args[i+1]
+			} else if strings.HasPrefix(arg, prefix+name+"=") {
+				return strings.TrimPrefix(arg, prefix+name+"=")
+			}
+		}
+	}
+	return ""
+}
+
+// removeFlags removes the boolean flags from arguments
+func removeFlags(args []string, flags ...string) []string {
+	out := []string{}
+	for _, arg := range args {
+		skip := false
+		for _, flag := range flags {
+			if arg == flag {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			out = append(out, arg)
+		}
+	}
+	return out
+}
+
+func newCacheInstance(args []string) *cacheInstance {
+	id := getFlagValue(args, "id")
+	if len(id) == 0 {
+		id = DEFAULT_CACHE_ID
+	}
+	listen := getFlagValue(args, "listen_ip")
+	if port := getFlagValue(args, "listen_port"); len(port) > 0 {
+		listen = fmt.Sprintf("%s:%s", listen, port)
+	}
+	return &cacheInstance{Id: id, Args: args, Listen: listen}
+}
+
+func pidFile(dingocli *cli.DingoCli, id string) string {
+	return filepath.Join(dingocli.DataDir(), "cache", id+".pid")
+}
+
+func logFile(dingocli *cli.DingoCli, id string) string {
+	return filepath.Join(dingocli.LogDir(), fmt.Sprintf("dingo-cache-%s.log", id))
+}
+
+func writePidFile(filename string, pid int) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, []byte(strconv.Itoa(pid)), 0644)
+}
+
+func readPidFile(filename string) (int, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
+
+func isRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+// readCmdline returns the arguments of process from /proc/<pid>/cmdline
+func readCmdline(pid int) ([]string, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimRight(string(data), "\x00"), "\x00"), nil
+}
+
+// isCacheCmdline reports whether the arguments belong to dingo-cache of
+// the instance, or to the supervisor (dingo cache start --supervise) of it
+func isCacheCmdline(cmdline []string, id string) bool {
+	if len(cmdline) == 0 {
+		return false
+	}
+
+	args := cmdline[1:]
+	if filepath.Base(cmdline[0]) != CACHE_CLIENT_KIND {
+		if len(args) < 3 || args[0] != "cache" || args[1] != "start" || args[2] != "--supervise" {
+			return false
+		}
+		args = args[3:]
+	}
+	return newCacheInstance(args).Id == id
+}
+
+// checkPidFile returns the pid in pidfile if the process is alive and it is
+// the instance, otherwise the pidfile is stale (e.g. the pid is reused by
+// another process after reboot) and removed
+func checkPidFile(filename, id string) int {
+	pid, err := readPidFile(filename)
+	if err != nil {
+		return 0
+	} else if isRunning(pid) {
+		cmdline, err := readCmdline(pid)
+		if err == nil && isCacheCmdline(cmdline, id) {
+			return pid
+		}
+	}
+	os.Remove(filename)
+	return 0
+}
+
+// runningPid returns the pid in pidfile if the instance is alive, or 0
+func runningPid(dingocli *cli.DingoCli, id string) int {
+	return checkPidFile(pidFile(dingocli, id), id)
+}
+
+func decodeInstance(client storage.Client) (*cacheInstance, error) {
+	instance := &cacheInstance{}
+	if err := json.Unmarshal([]byte(client.AuxInfo), instance); err != nil {
+		return nil, errno.ERR_GET_CLIENT_BY_ID_FAILED.E(err)
+	}
+	return instance, nil
+}
+
+func getInstance(dingocli *cli.DingoCli, id string) (*cacheInstance, error) {
+	clients, err := dingocli.Storage().GetClient(id)
+	if err != nil {
+		return nil, errno.ERR_GET_CLIENT_BY_ID_FAILED.E(err)
+	} else if len(clients) == 0 || clients[0].Kind != CACHE_CLIENT_KIND {
+		return nil, errno.ERR_CACHE_INSTANCE_NOT_FOUND.F("id: %s", id)
+	}
+	return decodeInstance(clients[0])
+}
+
+func getInstances(dingocli *cli.DingoCli) ([]*cacheInstance, error) {
+	clients, err := dingocli.Storage().GetClients()
+	if err != nil {
+		return nil, errno.ERR_GET_ALL_CLIENTS_FAILED.E(err)
+	}
+
+	instances := []*cacheInstance{}
+	for _, client := range clients {
+		if client.Kind != CACHE_CLIENT_KIND {
+			continue
+		}
+		instance, err := decodeInstance(client)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+func saveInstance(dingocli *cli.DingoCli, instance *cacheInstance) error {
+	data, err := json.Marshal(instance)
+	if err != nil {
+		return errno.ERR_SET_CLIENT_AUX_INFO_FAILED.E(err)
+	}
+
+	clients, err := dingocli.Storage().GetClient(instance.Id)
+	if err != nil {
+		return errno.ERR_GET_CLIENT_BY_ID_FAILED.E(err)
+	} else if len(clients) == 0 {
+		err = dingocli.Storage().InsertClient(instance.Id, CACHE_CLIENT_KIND, CACHE_CLIENT_HOST, "", string(data))
+		if err != nil {
+			return errno.ERR_INSERT_CLIENT_FAILED.E(err)
+		}
+		return nil
+	}
+
+	if err := dingocli.Storage().SetClientAuxInfo(instance.Id, string(data)); err != nil {
+		return errno.ERR_SET_CLIENT_AUX_INFO_FAILED.E(err)
+	}
+	return nil
+}
+
+// startDetached starts the process in a new session with output redirected to
+// log file, and makes sure it is still alive after a while
+func startDetached(binary string, args []string, logfile string) (int, error) {
+	if err := os.MkdirAll(filepath.Dir(logfile), 0755); err != nil {
+		return 0, err
+	}
+	file, err := os.OpenFile(logfile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	oscmd := exec.Command(binary, args...)
+	oscmd.Stdout = file
+	oscmd.Stderr = file
+	oscmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := oscmd.Start(); err != nil {
+		return 0, err
+	}
+
+	exited := make(chan error, 1)
+	go func() { exited <- oscmd.Wait() }()
+	select {
+	case err := <-exited:
+		return 0, fmt.Errorf("process exited: %v, see %s for details", err, logfile)
+	case <-time.After(CACHE_START_WAIT):
+	}
+	return oscmd.Process.Pid, nil
+}
+
+// stopProcess sends SIGTERM and waits the process to exit, SIGKILL is sent
+// on timeout if force is set
+func stopProcess(pid int, timeout time.Duration, force bool) error {
+	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil {
+		if err == syscall.ESRCH {
+			return nil
+		}
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for isRunning(pid) {
+		if time.Now().After(deadline) {
+			if !force {
+				return fmt.Errorf("process %d is still running after %s", pid, timeout)
+			}
+			syscall.Kill(pid, syscall.SIGKILL)
+			time.Sleep(500 * time.Millisecond)
+			return nil
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCacheInstance(t *testing.T) {
+	assert := assert.New(t)
+
+	args := []string{"--id=85a4b352", "--listen_ip", "10.220.69.6", "-listen_port=8888", "--conf", "cache.conf"}
+	instance := newCacheInstance(args)
+	assert.Equal("85a4b352", instance.Id)
+	assert.Equal("10.220.69.6:8888", instance.Listen)
+	assert.Equal(args, instance.Args)
+
+	instance = newCacheInstance([]string{"--conf=cache.conf"})
+	assert.Equal(DEFAULT_CACHE_ID, instance.Id)
+	assert.Equal("", instance.Listen)
+}
+
+func TestRemoveFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	args := []string{"--id=1", "-d", "--supervise", "--conf", "cache.conf"}
+	assert.Equal([]string{"--id=1", "--conf", "cache.conf"},
+		removeFlags(args, "--daemonize", "-d", "--supervise"))
+}
+
+func TestNextBackoff(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(2*time.Second, nextBackoff(SUPERVISE_MIN_BACKOFF))
+	assert.Equal(SUPERVISE_MAX_BACKOFF, nextBackoff(40*time.Second))
+	assert.Equal(SUPERVISE_MAX_BACKOFF, nextBackoff(SUPERVISE_MAX_BACKOFF))
+}
+
+func TestPidFile(t *testing.T) {
+	assert := assert.New(t)
+
+	filename := filepath.Join(t.TempDir(), "cache", "1.pid")
+	assert.NoError(writePidFile(filename, os.Getpid()))
+	pid, err := readPidFile(filename)
+	assert.NoError(err)
+	assert.Equal(os.Getpid(), pid)
+	assert.True(isRunning(pid))
+	assert.False(isRunning(0))
+}
+
+func TestStartAndStopDetached(t *testing.T) {
+	assert := assert.New(t)
+
+	logfile := filepath.Join(t.TempDir(), "sleep.log")
+	pid, err := startDetached("sleep", []string{"30"}, logfile)
+	assert.NoError(err)
+	assert.True(isRunning(pid))
+	assert.NoError(stopProcess(pid, 5*time.Second, true))
+
+	// exit immediately
+	_, err = startDetached("false", []string{}, logfile)
+	assert.Error(err)
+}
+
+func TestIsCacheCmdline(t *testing.T) {
+	assert := assert.New(t)
+
+	binary := "/root/.dingo/components/dingo-cache/v1.0.0/dingo-cache"
+	assert.True(isCacheCmdline([]string{binary, "--id=1", "--conf", "cache.conf"}, "1"))
+	assert.True(isCacheCmdline([]string{binary, "--conf=cache.conf"}, DEFAULT_CACHE_ID))
+	assert.True(isCacheCmdline([]string{"/usr/bin/dingo", "cache", "start", "--supervise", "--id", "1"}, "1"))
+	assert.False(isCacheCmdline([]string{binary, "--id=2"}, "1"))
+	assert.False(isCacheCmdline([]string{"/usr/bin/sleep", "--id=1"}, "1"))
+	assert.False(isCacheCmdline([]string{"/usr/bin/dingo", "cache", "stop", "1"}, "1"))
+	assert.False(isCacheCmdline([]string{}, "1"))
+}
+
+func TestCheckPidFile(t *testing.T) {
+	assert := assert.New(t)
+
+	// the pid is alive but it is not dingo-cache
+	logfile := filepath.Join(t.TempDir(), "sleep.log")
+	pid, err := startDetached("sleep", []string{"30"}, logfile)
+	assert.NoError(err)
+	defer stopProcess(pid, 5*time.Second, true)
+
+	cmdline, err := readCmdline(pid)
+	assert.NoError(err)
+	assert.Equal([]string{"sleep", "30"}, cmdline)
+
+	filename := filepath.Join(t.TempDir(), "cache", "1.pid")
+	assert.NoError(writePidFile(filename, pid))
+	assert.Equal(0, checkPidFile(filename, "1"))
+	assert.NoFileExists(filename)
+	assert.True(isRunning(pid))
+
+	// no pidfile
+	assert.Equal(0, checkPidFile(filename, "1"))
+}
@@ -35,6 +35,7 @@ func NewCacheMemberCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewCacheMemberDeleteCommand(dingocli),
 		NewCacheMemberUnlockCommand(dingocli),
 		NewCacheMemberLeaveCommand(dingocli),
+		NewCacheMemberDrainCommand(dingocli),
 	)
 
 	return cmd
@@ -0,0 +1,335 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package member
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHEMEMBER_DRAIN_EXAMPLE = `Examples:
+   # move the traffic away in 4 steps, then leave the member from its group
+   $ dingo cache member drain --member-id 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+
+   # drain slowly and wait at most 30 minutes for the traffic to move
+   $ dingo cache member drain --member-id 6ba7b810-9dad-11d1-80b4-00c04fd430c8 --steps 10 --interval 1m --timeout 30m
+
+   # restore the weight of an interrupted drain
+   $ dingo cache member drain --member-id 6ba7b810-9dad-11d1-80b4-00c04fd430c8 --rollback`
+
+	// the range requests served by the block cache service of cache node
+	DEFAULT_DRAIN_METRIC = "dingofs_block_cache_service_range_count"
+
+	DRAIN_STATUS_REWEIGHTING = "reweighting"
+	DRAIN_STATUS_WAITING     = "waiting"
+	DRAIN_STATUS_LEAVING     = "leaving"
+)
+
+type drainOptions struct {
+	memberId  string
+	steps     uint32
+	interval  time.Duration
+	timeout   time.Duration
+	metric    string
+	threshold float64
+	rollback  bool
+	noConfirm bool
+}
+
+// drainState is saved before any change, so an interrupted drain can be
+// resumed or rolled back
+type drainState struct {
+	MemberId  string    `json:"member_id"`
+	Group     string    `json:"group"`
+	Ip        string    `json:"ip"`
+	Port      uint32    `json:"port"`
+	Weight    uint32    `json:"weight"`
+	Current   uint32    `json:"current"`
+	Status    string    `json:"status"`
+	StartTime time.Time `json:"start_time"`
+}
+
+func NewCacheMemberDrainCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options drainOptions
+
+	cmd := &cobra.Command{
+		Use:     "drain [OPTIONS]",
+		Short:   "Move traffic away from cache member stepwise and leave it from group",
+		Args:    utils.NoArgs,
+		Example: CACHEMEMBER_DRAIN_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.noConfirm = utils.GetBoolFlag(cmd, utils.DINGOFS_NOCONFIRM)
+			if options.steps == 0 {
+				return fmt.Errorf("--steps must be greater than 0")
+			} else if options.interval <= 0 {
+				return fmt.Errorf("--interval must be greater than 0")
+			}
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			if options.rollback {
+				return runDrainRollback(cmd, dingocli, options)
+			}
+			return runDrain(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	flags := cmd.Flags()
+	flags.StringVar(&options.memberId, "member-id", "", "Cache member id")
+	cmd.MarkFlagRequired("member-id")
+	flags.Uint32Var(&options.steps, "steps", 4, "Number of steps to reduce the weight to 0")
+	flags.DurationVar(&options.interval, "interval", 30*time.Second, "Interval between two steps")
+	flags.DurationVar(&options.timeout, "timeout", 10*time.Minute, "Timeout of waiting for the traffic to move after weight is 0")
+	flags.StringVar(&options.metric, "metric", DEFAULT_DRAIN_METRIC, "Request counter exported by the cache member, the traffic is counted by its increment")
+	flags.Float64Var(&options.threshold, "threshold", 0, "Requests per second under which the traffic is considered moved")
+	flags.BoolVar(&options.rollback, "rollback", false, "Restore the weight saved by an interrupted drain")
+
+	utils.AddBoolFlag(cmd, utils.DINGOFS_NOCONFIRM, "Do not confirm the command")
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// drainWeights returns the weights of each step, the last one is always 0,
+// e.g. (100, 4) => [75 50 25 0]
+func drainWeights(weight, steps uint32) []uint32 {
+	if steps == 0 || weight == 0 {
+		return []uint32{0}
+	}
+
+	weights := []uint32{}
+	for i := uint32(1); i <= steps; i++ {
+		w := uint32(uint64(weight) * uint64(steps-i) / uint64(steps))
+		if len(weights) > 0 && weights[len(weights)-1] == w {
+			continue
+		}
+		weights = append(weights, w)
+	}
+	return weights
+}
+
+func requestRate(prev, curr float64, elapsed time.Duration) float64 {
+	if elapsed <= 0 || curr < prev { // counter reset
+		return 0
+	}
+	return (curr - prev) / elapsed.Seconds()
+}
+
+// getMemberRequests returns the requests served by the member, which is
+// counted by the server side metric exported by the cache node
+func getMemberRequests(state *drainState, metric string) (float64, error) {
+	return utils.GetMetricValue(state.Ip, state.Port, metric)
+}
+
+func getDrainState(dingocli *cli.DingoCli, memberId string) (*drainState, error) {
+	items, err := dingocli.Storage().GetCacheMemberDrain(memberId)
+	if err != nil {
+		return nil, errno.ERR_SELECT_CACHE_MEMBER_DRAIN_FAILED.E(err)
+	} else if len(items) == 0 {
+		return nil, nil
+	}
+
+	state := &drainState{}
+	if err := json.Unmarshal([]byte(items[0].Data), state); err != nil {
+		return nil, errno.ERR_SELECT_CACHE_MEMBER_DRAIN_FAILED.E(err)
+	}
+	return state, nil
+}
+
+func saveDrainState(dingocli *cli.DingoCli, state *drainState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errno.ERR_SET_CACHE_MEMBER_DRAIN_FAILED.E(err)
+	}
+	err = dingocli.Storage().SetCacheMemberDrain(state.MemberId, string(data))
+	if err != nil {
+		return errno.ERR_SET_CACHE_MEMBER_DRAIN_FAILED.E(err)
+	}
+	return nil
+}
+
+func reweight(cmd *cobra.Command, dingocli *cli.DingoCli, state *drainState, weight uint32) error {
+	err := rpc.ReweightCacheMember(cmd, state.MemberId, state.Ip, state.Port, weight)
+	dingocli.RecordAudit(err, "cache member drain --member-id %s: reweight %d -> %d",
+		state.MemberId, state.Current, weight)
+	if err != nil {
+		return err
+	}
+
+	dingocli.WriteOutln("Reweight cache member %s: %d -> %d", state.MemberId, state.Current, weight)
+	state.Current = weight
+	return saveDrainState(dingocli, state)
+}
+
+// waitTrafficMoved waits until the requests served by the member fall under
+// the threshold, it keeps retrying until timeout if the metric is unavailable
+func waitTrafficMoved(dingocli *cli.DingoCli, state *drainState, options drainOptions) error {
+	deadline := time.Now().Add(options.timeout)
+	prev, err := getMemberRequests(state, options.metric)
+	last := time.Now()
+	for {
+		time.Sleep(options.interval)
+
+		if err != nil {
+			logger.Warnf("get metric of cache member %s failed: %v", state.MemberId, err)
+			dingocli.WriteOutln("Cache member %s: metric %s is unavailable", state.MemberId, options.metric)
+			prev, err = getMemberRequests(state, options.metric)
+			last = time.Now()
+		} else {
+			var curr float64
+			curr, err = getMemberRequests(state, options.metric)
+			if err == nil {
+				rate := requestRate(prev, curr, time.Since(last))
+				dingocli.WriteOutln("Cache member %s serves %.2f requests/s", state.MemberId, rate)
+				if rate <= options.threshold {
+					return nil
+				}
+				prev, last = curr, time.Now()
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return errno.ERR_WAIT_CACHE_MEMBER_IDLE_TIMEOUT.
+				F("memberid: %s, run with --rollback to restore its weight", state.MemberId)
+		}
+	}
+}
+
+func runDrain(cmd *cobra.Command, dingocli *cli.DingoCli, options drainOptions) error {
+	member, err := rpc.GetCacheMember(cmd, options.memberId)
+	if err != nil {
+		return err
+	}
+
+	// resume the interrupted drain, keep the original weight for rollback
+	state, err := getDrainState(dingocli, options.memberId)
+	if err != nil {
+		return err
+	} else if state == nil {
+		state = &drainState{
+			MemberId:  member.GetMemberId(),
+			Weight:    member.GetWeight(),
+			StartTime: time.Now(),
+		}
+	}
+	state.Group = member.GetGroupName()
+	state.Ip = member.GetIp()
+	state.Port = member.GetPort()
+	state.Current = member.GetWeight()
+
+	if !options.noConfirm && !utils.AskConfirmation(
+		fmt.Sprintf("Are you sure to drain cachemember %s and leave it from group %s?", state.MemberId, state.Group),
+		state.MemberId) {
+		return errno.ERR_CANCEL_OPERATION
+	}
+
+	// 1) reduce the weight stepwise
+	state.Status = DRAIN_STATUS_REWEIGHTING
+	if err := saveDrainState(dingocli, state); err != nil {
+		return err
+	}
+	weights := drainWeights(state.Current, options.steps)
+	for i, weight := range weights {
+		if weight == state.Current {
+			continue
+		}
+		if err := reweight(cmd, dingocli, state, weight); err != nil {
+			return err
+		}
+		if i != len(weights)-1 {
+			time.Sleep(options.interval)
+		}
+	}
+
+	// 2) wait the traffic moved to other members
+	state.Status = DRAIN_STATUS_WAITING
+	if err := saveDrainState(dingocli, state); err != nil {
+		return err
+	}
+	err = waitTrafficMoved(dingocli, state, options)
+	dingocli.RecordAudit(err, "cache member drain --member-id %s: wait traffic moved", state.MemberId)
+	if err != nil {
+		return err
+	}
+
+	// 3) leave the member from group
+	state.Status = DRAIN_STATUS_LEAVING
+	if err := saveDrainState(dingocli, state); err != nil {
+		return err
+	}
+	err = rpc.LeaveCacheMember(cmd, state.Group, state.MemberId, state.Ip, state.Port)
+	dingocli.RecordAudit(err, "cache member drain --member-id %s: leave group %s", state.MemberId, state.Group)
+	if err != nil {
+		return err
+	}
+
+	if err := dingocli.Storage().DeleteCacheMemberDrain(state.MemberId); err != nil {
+		return errno.ERR_DELETE_CACHE_MEMBER_DRAIN_FAILED.E(err)
+	}
+	dingocli.WriteOutln("Successfully drain cachemember %s", state.MemberId)
+	return nil
+}
+
+func runDrainRollback(cmd *cobra.Command, dingocli *cli.DingoCli, options drainOptions) error {
+	state, err := getDrainState(dingocli, options.memberId)
+	if err != nil {
+		return err
+	} else if state == nil {
+		return errno.ERR_CACHE_MEMBER_NOT_DRAINING.F("memberid: %s", options.memberId)
+	}
+
+	member, err := rpc.GetCacheMember(cmd, state.MemberId)
+	if err != nil {
+		return err
+	}
+	state.Current = member.GetWeight()
+	if state.Current != state.Weight {
+		if err := reweight(cmd, dingocli, state, state.Weight); err != nil {
+			return err
+		}
+	}
+
+	if err := dingocli.Storage().DeleteCacheMemberDrain(state.MemberId); err != nil {
+		return errno.ERR_DELETE_CACHE_MEMBER_DRAIN_FAILED.E(err)
+	}
+	dingocli.WriteOutln("Successfully rollback cachemember %s to weight %d", state.MemberId, state.Weight)
+	return nil
+}
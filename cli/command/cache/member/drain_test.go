@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainWeights(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]uint32{75, 50, 25, 0}, drainWeights(100, 4))
+	assert.Equal([]uint32{0}, drainWeights(100, 1))
+	assert.Equal([]uint32{0}, drainWeights(0, 4))
+	// duplicate weights are skipped for small weight
+	assert.Equal([]uint32{2, 1, 0}, drainWeights(3, 5))
+}
+
+func TestRequestRate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(float64(10), requestRate(100, 200, 10*time.Second))
+	assert.Equal(float64(0), requestRate(200, 100, 10*time.Second))
+	assert.Equal(float64(0), requestRate(100, 200, 0))
+}
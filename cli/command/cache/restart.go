@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHE_RESTART_EXAMPLE = `Examples:
+   $ dingo cache restart 85a4b352-4097-4868-9cd6-9ec5e53db1b6`
+)
+
+type restartOptions struct {
+	ids     []string
+	force   bool
+	timeout time.Duration
+}
+
+func NewCacheRestartCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options restartOptions
+
+	cmd := &cobra.Command{
+		Use:     "restart ID... [OPTIONS]",
+		Short:   "restart cache node in background with the same arguments",
+		Args:    utils.RequiresMinArgs(1),
+		Example: CACHE_RESTART_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.ids = args
+			return runRestart(dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	flags := cmd.Flags()
+	flags.BoolVarP(&options.force, "force", "f", false, "Kill the process if it does not exit in time")
+	flags.DurationVar(&options.timeout, "timeout", 60*time.Second, "Time to wait for the process to exit")
+
+	return cmd
+}
+
+func runRestart(dingocli *cli.DingoCli, options restartOptions) error {
+	for _, id := range options.ids {
+		instance, err := getInstance(dingocli, id)
+		if err != nil {
+			return err
+		}
+		if err := stopInstance(dingocli, instance, options.timeout, options.force); err != nil {
+			return err
+		}
+
+		// the active version may be changed since last start
+		component, err := getCacheComponent()
+		if err != nil {
+			return err
+		}
+		instance.Binary = filepath.Join(component.Path, component.Name)
+		instance.Version = component.Version
+		instance.ChildPid = 0
+		instance.Restarts = 0
+		if err := startInstance(dingocli, instance, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
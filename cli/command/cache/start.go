@@ -20,12 +20,16 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 	"time"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	compmgr "github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
 	"github.com/fatih/color"
 
 	"github.com/spf13/cobra"
@@ -33,13 +37,22 @@ import (
 
 const (
 	CACHE_START_EXAMPLE = `Examples:
-   $ dingo cache start --id=85a4b352-4097-4868-9cd6-9ec5e53db1b6 --listen_ip=10.220.69.6`
+   $ dingo cache start --id=85a4b352-4097-4868-9cd6-9ec5e53db1b6 --listen_ip=10.220.69.6
+
+   # run in background and restart it with backoff when it crashes
+   $ dingo cache start --id=85a4b352-4097-4868-9cd6-9ec5e53db1b6 --listen_ip=10.220.69.6 --daemonize --supervise`
+
+	SUPERVISE_MIN_BACKOFF = 1 * time.Second
+	SUPERVISE_MAX_BACKOFF = 60 * time.Second
+	// the backoff is reset if the process has been running for a while
+	SUPERVISE_RESET_AFTER = 5 * time.Minute
 )
 
 type startOptions struct {
 	cacheBinary string
 	cmdArgs     []string
 	daemonize   bool
+	supervise   bool
 }
 
 func NewCacheStartCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -52,33 +65,12 @@ func NewCacheStartCommand(dingocli *cli.DingoCli) *cobra.Command {
 		DisableFlagParsing: true,
 		Example:            CACHE_START_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			options.cmdArgs = args
-
-			componentManager, err := compmgr.NewComponentManager()
+			component, err := getCacheComponent()
 			if err != nil {
 				return err
 			}
-			component, err := componentManager.GetActiveComponent(compmgr.DINGO_DACHE)
-			if err != nil {
-				fmt.Printf("%s: %v\n", color.BlueString("[WARNING]"), err)
-				component, err = componentManager.InstallComponent(compmgr.DINGO_DACHE, compmgr.MAIN_VERSION)
-				if err != nil {
-					return fmt.Errorf("failed to install dingo-cache binary: %v", err)
-				}
-			}
-
 			options.cacheBinary = filepath.Join(component.Path, component.Name)
 
-			// check dingo-cache is exists
-			if !utils.IsFileExists(options.cacheBinary) {
-				return fmt.Errorf("%s not found, run dingo component install dingo-cache:[VERSION] to install.", options.cacheBinary)
-			}
-
-			// add execute permission
-			if err := utils.AddExecutePermission(options.cacheBinary); err != nil {
-				return fmt.Errorf("failed to add execute permission for %s,error: %v", options.cacheBinary, err)
-			}
-
 			// check flags
 			for _, arg := range args {
 				if arg == "--help" || arg == "-h" {
@@ -90,11 +82,20 @@ func NewCacheStartCommand(dingocli *cli.DingoCli) *cobra.Command {
 				if arg == "--daemonize" || arg == "-d" {
 					options.daemonize = true
 				}
+				if arg == "--supervise" {
+					options.supervise = true
+				}
 			}
+			// dingo-cache is detached by us, so we know its pid
+			options.cmdArgs = removeFlags(args, "--daemonize", "-d", "--supervise")
 
 			fmt.Println(color.CyanString("use %s:%s(%s)\n", component.Name, component.Version, options.cacheBinary))
 
-			return runStart(cmd, dingocli, options)
+			instance := newCacheInstance(options.cmdArgs)
+			instance.Binary = options.cacheBinary
+			instance.Version = component.Version
+			instance.Supervise = options.supervise
+			return startInstance(dingocli, instance, options.daemonize)
 		},
 		SilenceUsage:          false,
 		DisableFlagsInUseLine: true,
@@ -105,14 +106,77 @@ func NewCacheStartCommand(dingocli *cli.DingoCli) *cobra.Command {
 	return cmd
 }
 
-func runStart(cmd *cobra.Command, dingocli *cli.DingoCli, options startOptions) error {
-	var oscmd *exec.Cmd
-	var name string
+// getCacheComponent returns the active dingo-cache component, install it if not exist
+func getCacheComponent() (*compmgr.Component, error) {
+	componentManager, err := compmgr.NewComponentManager()
+	if err != nil {
+		return nil, err
+	}
+	component, err := componentManager.GetActiveComponent(compmgr.DINGO_DACHE)
+	if err != nil {
+		fmt.Printf("%s: %v\n", color.BlueString("[WARNING]"), err)
+		component, err = componentManager.InstallComponent(compmgr.DINGO_DACHE, compmgr.MAIN_VERSION)
+		if err != nil {
+			return nil, fmt.Errorf("failed to install dingo-cache binary: %v", err)
+		}
+	}
+
+	cacheBinary := filepath.Join(component.Path, component.Name)
+
+	// check dingo-cache is exists
+	if !utils.IsFileExists(cacheBinary) {
+		return nil, fmt.Errorf("%s not found, run dingo component install dingo-cache:[VERSION] to install.", cacheBinary)
+	}
 
-	name = options.cacheBinary
-	cmdarg := options.cmdArgs
+	// add execute permission
+	if err := utils.AddExecutePermission(cacheBinary); err != nil {
+		return nil, fmt.Errorf("failed to add execute permission for %s,error: %v", cacheBinary, err)
+	}
 
-	oscmd = exec.Command(name, cmdarg...)
+	return component, nil
+}
+
+func startInstance(dingocli *cli.DingoCli, instance *cacheInstance, daemonize bool) error {
+	if pid := runningPid(dingocli, instance.Id); pid > 0 {
+		return errno.ERR_CACHE_INSTANCE_ALREADY_RUNNING.F("id: %s, pid: %d", instance.Id, pid)
+	}
+
+	switch {
+	case daemonize && instance.Supervise:
+		// run the supervisor itself in background
+		self, err := os.Executable()
+		if err != nil {
+			return errno.ERR_START_CACHE_INSTANCE_FAILED.E(err)
+		}
+		args := append([]string{"cache", "start", "--supervise"}, instance.Args...)
+		if _, err := startDetached(self, args, logFile(dingocli, instance.Id)); err != nil {
+			return errno.ERR_START_CACHE_INSTANCE_FAILED.E(err)
+		}
+	case instance.Supervise:
+		return runSupervise(dingocli, instance)
+	case daemonize:
+		pid, err := startDetached(instance.Binary, instance.Args, logFile(dingocli, instance.Id))
+		if err != nil {
+			return errno.ERR_START_CACHE_INSTANCE_FAILED.E(err)
+		}
+		instance.Pid = pid
+		instance.StartTime = time.Now()
+		if err := writePidFile(pidFile(dingocli, instance.Id), pid); err != nil {
+			return errno.ERR_START_CACHE_INSTANCE_FAILED.E(err)
+		}
+		if err := saveInstance(dingocli, instance); err != nil {
+			return err
+		}
+	default:
+		return runStart(dingocli, instance)
+	}
+
+	fmt.Printf("Successfully start dingo-cache %s, log: %s\n", instance.Id, logFile(dingocli, instance.Id))
+	return nil
+}
+
+func runStart(dingocli *cli.DingoCli, instance *cacheInstance) error {
+	oscmd := exec.Command(instance.Binary, instance.Args...)
 
 	oscmd.Stdout = os.Stdout
 	oscmd.Stderr = os.Stderr
@@ -121,11 +185,15 @@ func runStart(cmd *cobra.Command, dingocli *cli.DingoCli, options startOptions)
 		return err
 	}
 
-	// forground mode, wait process exit
-	if options.daemonize {
-		time.Sleep(2 * time.Second)
-		fmt.Println("Successfully start dingo-cache")
-		return nil
+	pidfile := pidFile(dingocli, instance.Id)
+	instance.Pid = oscmd.Process.Pid
+	instance.StartTime = time.Now()
+	if err := writePidFile(pidfile, instance.Pid); err != nil {
+		logger.Warnf("write pidfile %s failed: %v", pidfile, err)
+	}
+	defer os.Remove(pidfile)
+	if err := saveInstance(dingocli, instance); err != nil {
+		logger.Warnf("save cache instance %s failed: %v", instance.Id, err)
 	}
 
 	// wait process complete
@@ -135,3 +203,68 @@ func runStart(cmd *cobra.Command, dingocli *cli.DingoCli, options startOptions)
 
 	return nil
 }
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > SUPERVISE_MAX_BACKOFF {
+		backoff = SUPERVISE_MAX_BACKOFF
+	}
+	return backoff
+}
+
+// runSupervise runs dingo-cache in foreground and restarts it with backoff
+// when it crashes, SIGTERM(SIGINT) is forwarded to dingo-cache
+func runSupervise(dingocli *cli.DingoCli, instance *cacheInstance) error {
+	pidfile := pidFile(dingocli, instance.Id)
+	if err := writePidFile(pidfile, os.Getpid()); err != nil {
+		return errno.ERR_START_CACHE_INSTANCE_FAILED.E(err)
+	}
+	defer os.Remove(pidfile)
+	instance.Pid = os.Getpid()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	defer signal.Stop(signals)
+
+	backoff := SUPERVISE_MIN_BACKOFF
+	for {
+		oscmd := exec.Command(instance.Binary, instance.Args...)
+		oscmd.Stdout = os.Stdout
+		oscmd.Stderr = os.Stderr
+		if err := oscmd.Start(); err != nil {
+			return errno.ERR_START_CACHE_INSTANCE_FAILED.E(err)
+		}
+
+		instance.ChildPid = oscmd.Process.Pid
+		instance.StartTime = time.Now()
+		if err := saveInstance(dingocli, instance); err != nil {
+			logger.Warnf("save cache instance %s failed: %v", instance.Id, err)
+		}
+
+		exited := make(chan error, 1)
+		go func() { exited <- oscmd.Wait() }()
+
+		var err error
+		select {
+		case <-signals:
+			return stopProcess(oscmd.Process.Pid, CACHE_STOP_TIMEOUT, true)
+		case err = <-exited:
+		}
+		if err == nil {
+			fmt.Printf("dingo-cache %s exited\n", instance.Id)
+			return nil
+		}
+
+		if time.Since(instance.StartTime) > SUPERVISE_RESET_AFTER {
+			backoff = SUPERVISE_MIN_BACKOFF
+		}
+		fmt.Printf("dingo-cache %s exited: %v, restart in %s\n", instance.Id, err, backoff)
+		select {
+		case <-signals:
+			return nil
+		case <-time.After(backoff):
+		}
+		instance.Restarts++
+		backoff = nextBackoff(backoff)
+	}
+}
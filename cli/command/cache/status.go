@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHE_STATUS_EXAMPLE = `Examples:
+   $ dingo cache status`
+
+	CACHE_STATUS_RUNNING = "running"
+	CACHE_STATUS_STOPPED = "stopped"
+)
+
+type statusOptions struct {
+	format string
+}
+
+type instanceStatus struct {
+	Id       string `json:"id"`
+	Status   string `json:"status"`
+	Pid      int    `json:"pid"`
+	Version  string `json:"version"`
+	Listen   string `json:"listen"`
+	Uptime   string `json:"uptime"`
+	Restarts int    `json:"restarts"`
+	Group    string `json:"group"`
+	State    string `json:"state"`
+}
+
+func NewCacheStatusCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options statusOptions
+
+	cmd := &cobra.Command{
+		Use:     "status [OPTIONS]",
+		Short:   "show cache nodes started by dingo cache start",
+		Args:    utils.NoArgs,
+		Example: CACHE_STATUS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runStatus(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runStatus(cmd *cobra.Command, dingocli *cli.DingoCli, options statusOptions) error {
+	instances, err := getInstances(dingocli)
+	if err != nil {
+		return err
+	}
+
+	// the group membership is reported by mds, it is optional for status
+	groups := map[string]string{}
+	states := map[string]string{}
+	if len(instances) > 0 {
+		members, err := rpc.ListCacheMembers(cmd, "")
+		if err != nil {
+			logger.Warnf("list cache members failed: %v", err)
+		}
+		for _, member := range members {
+			groups[member.GetMemberId()] = member.GetGroupName()
+			states[member.GetMemberId()] = utils.TranslateCacheGroupMemberState(member.GetState())
+		}
+	}
+
+	statuses := []*instanceStatus{}
+	for _, instance := range instances {
+		status := &instanceStatus{
+			Id:       instance.Id,
+			Status:   CACHE_STATUS_STOPPED,
+			Version:  instance.Version,
+			Listen:   instance.Listen,
+			Restarts: instance.Restarts,
+			Group:    groups[instance.Id],
+			State:    states[instance.Id],
+		}
+		if pid := runningPid(dingocli, instance.Id); pid > 0 {
+			status.Status = CACHE_STATUS_RUNNING
+			status.Pid = pid
+			if instance.Supervise && instance.ChildPid > 0 {
+				status.Pid = instance.ChildPid
+			}
+			status.Uptime = time.Since(instance.StartTime).Round(time.Second).String()
+		}
+		statuses = append(statuses, status)
+	}
+
+	if options.format == "json" {
+		return output.OutputJson(&common.OutputResult{Error: errno.ERR_OK, Result: statuses})
+	}
+
+	header := []string{
+		common.ROW_ID, common.ROW_STATUS, common.ROW_PID, common.ROW_VERSION, common.ROW_LISTEN,
+		common.ROW_UPTIME, common.ROW_RESTARTS, common.ROW_GROUP, common.ROW_STATE,
+	}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, status := range statuses {
+		noValue := func(value string) string {
+			return utils.Choose(len(value) > 0, value, common.ROW_VALUE_NO_VALUE)
+		}
+		row := make(map[string]string)
+		row[common.ROW_ID] = status.Id
+		row[common.ROW_STATUS] = status.Status
+		row[common.ROW_PID] = utils.Choose(status.Pid > 0, fmt.Sprintf("%d", status.Pid), common.ROW_VALUE_NO_VALUE)
+		row[common.ROW_VERSION] = noValue(status.Version)
+		row[common.ROW_LISTEN] = noValue(status.Listen)
+		row[common.ROW_UPTIME] = noValue(status.Uptime)
+		row[common.ROW_RESTARTS] = fmt.Sprintf("%d", status.Restarts)
+		row[common.ROW_GROUP] = noValue(status.Group)
+		row[common.ROW_STATE] = noValue(status.State)
+		rows = append(rows, row)
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_ID})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no cache instance")
+
+	return nil
+}
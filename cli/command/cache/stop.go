@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHE_STOP_EXAMPLE = `Examples:
+   $ dingo cache stop 85a4b352-4097-4868-9cd6-9ec5e53db1b6
+
+   # stop all cache instances and forget them
+   $ dingo cache stop --all --remove`
+)
+
+type stopOptions struct {
+	ids     []string
+	all     bool
+	force   bool
+	remove  bool
+	timeout time.Duration
+}
+
+func NewCacheStopCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options stopOptions
+
+	cmd := &cobra.Command{
+		Use:     "stop [ID...] [OPTIONS]",
+		Short:   "stop cache node started by dingo cache start",
+		Args:    utils.RequiresMinArgs(0),
+		Example: CACHE_STOP_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.ids = args
+			if len(args) == 0 && !options.all {
+				return fmt.Errorf("no cache instance is specified, use ID or --all")
+			}
+			return runStop(dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	flags := cmd.Flags()
+	flags.BoolVar(&options.all, "all", false, "Stop all cache instances")
+	flags.BoolVarP(&options.force, "force", "f", false, "Kill the process if it does not exit in time")
+	flags.BoolVar(&options.remove, "remove", false, "Remove the instance record after stopped")
+	flags.DurationVar(&options.timeout, "timeout", 60*time.Second, "Time to wait for the process to exit")
+
+	return cmd
+}
+
+func stopInstance(dingocli *cli.DingoCli, instance *cacheInstance, timeout time.Duration, force bool) error {
+	pid := runningPid(dingocli, instance.Id)
+	if pid == 0 {
+		return nil
+	}
+
+	if err := stopProcess(pid, timeout, force); err != nil {
+		return errno.ERR_STOP_CACHE_INSTANCE_FAILED.E(err)
+	}
+	os.Remove(pidFile(dingocli, instance.Id))
+	return nil
+}
+
+func runStop(dingocli *cli.DingoCli, options stopOptions) error {
+	instances := []*cacheInstance{}
+	if options.all {
+		var err error
+		if instances, err = getInstances(dingocli); err != nil {
+			return err
+		}
+	} else {
+		for _, id := range options.ids {
+			instance, err := getInstance(dingocli, id)
+			if err != nil {
+				return err
+			}
+			instances = append(instances, instance)
+		}
+	}
+
+	for _, instance := range instances {
+		if err := stopInstance(dingocli, instance, options.timeout, options.force); err != nil {
+			return err
+		}
+		if options.remove {
+			if err := dingocli.Storage().DeleteClient(instance.Id); err != nil {
+				return errno.ERR_DELETE_CLIENT_FAILED.E(err)
+			}
+		}
+		fmt.Printf("Successfully stop dingo-cache %s\n", instance.Id)
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tuicomm "github.com/dingodb/dingocli/internal/tui/common"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	BACKUP_EXAMPLE = `Examples:
+  $ dingo cluster backup                                  # Backup meta of current cluster
+  $ dingo cluster backup --keep 7                         # Backup and only keep the latest 7 backups
+  $ dingo cluster backup --schedule "0 2 * * *" --keep 7  # Backup at 02:00 every day
+  $ dingo cluster backup --schedule off                   # Remove the backup schedule
+  $ dingo cluster backup --list                           # List backups of current cluster`
+
+	BACKUP_ID_FORMAT    = "20060102-150405"
+	BACKUP_SCHEDULE_TAG = "# dingo-cluster-backup:"
+	BACKUP_SCHEDULE_OFF = "off"
+)
+
+var (
+	BACKUP_PLAYBOOK_STEPS = map[string]int{
+		comm.BACKUP_KIND_MDSMETA: playbook.BACKUP_MDS_META,
+		comm.BACKUP_KIND_ETCD:    playbook.BACKUP_ETCD_DATA,
+	}
+)
+
+type backupOptions struct {
+	output   string
+	keep     int
+	schedule string
+	list     bool
+}
+
+func NewBackupCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options backupOptions
+
+	cmd := &cobra.Command{
+		Use:     "backup [OPTIONS]",
+		Short:   "Backup cluster meta",
+		Args:    cliutil.NoArgs,
+		Example: BACKUP_EXAMPLE,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if options.keep < 0 {
+				return errno.ERR_INVALID_BACKUP_OPTION.F("--keep: %d", options.keep)
+			} else if len(options.schedule) > 0 && options.schedule != BACKUP_SCHEDULE_OFF {
+				if err := cliutil.CheckCronSchedule(options.schedule); err != nil {
+					return errno.ERR_INVALID_BACKUP_OPTION.E(err)
+				}
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.list {
+				return runListBackups(dingocli, options)
+			} else if len(options.schedule) > 0 {
+				return runScheduleBackup(dingocli, options)
+			}
+			return runBackup(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.output, "output", "o", "", "Specify the directory which backups stored in (default ~/.dingo/data/backups)")
+	flags.IntVar(&options.keep, "keep", 0, "Specify the number of latest backups to keep, 0 means keep all")
+	flags.StringVar(&options.schedule, "schedule", "", "Specify the cron schedule to backup periodically, \"off\" to remove it")
+	flags.BoolVarP(&options.list, "list", "l", false, "List backups of current cluster")
+
+	cliutil.SupportDryRun(cmd)
+	return cmd
+}
+
+// backups of cluster are stored in <output>/<cluster>/<backup id>
+func getBackupRoot(dingocli *cli.DingoCli, output string) string {
+	if len(output) == 0 {
+		output = filepath.Join(dingocli.DataDir(), "backups")
+	}
+	return filepath.Join(output, dingocli.ClusterName())
+}
+
+// list id of backups which have manifest, the oldest first
+func listBackupIds(root string) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		} else if !cliutil.IsFileExists(filepath.Join(root, entry.Name(), comm.BACKUP_MANIFEST)) {
+			continue
+		}
+		ids = append(ids, entry.Name())
+	}
+	sort.Strings(ids) // backup id is the time it created
+	return ids, nil
+}
+
+func expiredBackupIds(ids []string, keep int) []string {
+	if keep <= 0 || len(ids) <= keep {
+		return []string{}
+	}
+	return ids[:len(ids)-keep]
+}
+
+func pruneBackups(root string, keep int) ([]string, error) {
+	ids, err := listBackupIds(root)
+	if err != nil {
+		return nil, errno.ERR_PRUNE_BACKUP_FAILED.E(err)
+	}
+
+	expired := expiredBackupIds(ids, keep)
+	for _, id := range expired {
+		if err := os.RemoveAll(filepath.Join(root, id)); err != nil {
+			return nil, errno.ERR_PRUNE_BACKUP_FAILED.E(err)
+		}
+	}
+	return expired, nil
+}
+
+// mdsv2 cluster backups meta through mds-client, mdsv1 cluster backups the data of etcd
+func getBackupTarget(dcs []*topology.DeployConfig) (*topology.DeployConfig, error) {
+	for _, kind := range []string{comm.BACKUP_KIND_MDSMETA, comm.BACKUP_KIND_ETCD} {
+		for _, dc := range dcs {
+			if task.GetBackupKind(dc) == kind {
+				return dc, nil
+			}
+		}
+	}
+	return nil, errno.ERR_NO_BACKUP_TARGET_IN_TOPOLOGY
+}
+
+func genBackupPlaybook(dingocli *cli.DingoCli,
+	dcs []*topology.DeployConfig,
+	dir string) (*playbook.Playbook, error) {
+	dc, err := getBackupTarget(dcs)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    BACKUP_PLAYBOOK_STEPS[task.GetBackupKind(dc)],
+		Configs: []*topology.DeployConfig{dc},
+		Options: map[string]interface{}{
+			comm.KEY_BACKUP_DIR:         dir,
+			comm.KEY_ALL_DEPLOY_CONFIGS: dcs,
+		},
+	})
+	return pb, nil
+}
+
+func runBackup(dingocli *cli.DingoCli, options backupOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) generate backup playbook
+	root := getBackupRoot(dingocli, options.output)
+	dir := filepath.Join(root, time.Now().Format(BACKUP_ID_FORMAT))
+	pb, err := genBackupPlaybook(dingocli, dcs, dir)
+	if err != nil {
+		return err
+	}
+	pb.EnableCheckpoint(BACKUP_OPERATION)
+
+	// 3) run playbook, nothing is downloaded in dry-run
+	if dingocli.DryRun() {
+		return pb.Run()
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errno.ERR_CREATE_DIRECTORY_FAILED.E(err)
+	}
+	if err := pb.Run(); err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+
+	// 4) remove expired backups
+	expired, err := pruneBackups(root, options.keep)
+	if err != nil {
+		return err
+	}
+	for _, id := range expired {
+		dingocli.WriteOutln("Expired backup '%s' removed", id)
+	}
+
+	dingocli.WriteOutln(color.GreenString("Cluster '%s' successfully backed up to %s ^_^."),
+		dingocli.ClusterName(), dir)
+	return nil
+}
+
+func shortDigest(digest string) string {
+	if len(digest) > 12 {
+		return digest[:12]
+	}
+	return digest
+}
+
+func runListBackups(dingocli *cli.DingoCli, options backupOptions) error {
+	root := getBackupRoot(dingocli, options.output)
+	ids, err := listBackupIds(root)
+	if err != nil {
+		return errno.ERR_BACKUP_NOT_FOUND.E(err)
+	}
+
+	lines := [][]interface{}{}
+	title := []string{"Id", "Kind", "Host", "Size", "Sha256", "Create Time"}
+	first, second := tuicomm.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+	for _, id := range ids {
+		manifest, err := task.ReadBackupManifest(filepath.Join(root, id))
+		if err != nil {
+			return err
+		}
+		lines = append(lines, []interface{}{
+			manifest.Id,
+			manifest.Kind,
+			manifest.Host,
+			humanize.IBytes(uint64(manifest.Size)),
+			shortDigest(manifest.Sha256),
+			manifest.CreatedAt,
+		})
+	}
+	dingocli.WriteOut(tuicomm.FixedFormat(lines, 2))
+	return nil
+}
+
+// install (or remove if schedule is off) the crontab entry which backups
+// current cluster periodically on this host
+func runScheduleBackup(dingocli *cli.DingoCli, options backupOptions) error {
+	content, err := cliutil.ReadCrontab()
+	if err != nil {
+		return errno.ERR_UPDATE_BACKUP_SCHEDULE_FAILED.E(err)
+	}
+
+	line := ""
+	if options.schedule != BACKUP_SCHEDULE_OFF {
+		binary, err := os.Executable()
+		if err != nil {
+			return errno.ERR_UPDATE_BACKUP_SCHEDULE_FAILED.E(err)
+		}
+		output := options.output
+		if len(output) == 0 {
+			output = filepath.Dir(getBackupRoot(dingocli, ""))
+		}
+		logfile := filepath.Join(dingocli.LogDir(), fmt.Sprintf("backup-%s.log", dingocli.ClusterName()))
+		line = fmt.Sprintf("%s %s=%s %s cluster backup --output %s --keep %d >> %s 2>&1",
+			options.schedule, comm.KEY_ENV_ACTIVATE_CLUSTER, dingocli.ClusterName(),
+			binary, output, options.keep, logfile)
+	}
+
+	updated := cliutil.UpdateCrontab(content, BACKUP_SCHEDULE_TAG+dingocli.ClusterName(), line)
+	if dingocli.DryRun() {
+		dingocli.WriteOut("Dry run: crontab will be updated to:\n%s", updated)
+		return nil
+	} else if updated != content {
+		if err := cliutil.WriteCrontab(updated); err != nil {
+			return errno.ERR_UPDATE_BACKUP_SCHEDULE_FAILED.E(err)
+		}
+	}
+
+	if len(line) == 0 {
+		dingocli.WriteOutln("Backup schedule of cluster '%s' removed", dingocli.ClusterName())
+	} else {
+		dingocli.WriteOutln("Cluster '%s' will be backed up at '%s'", dingocli.ClusterName(), options.schedule)
+	}
+	return nil
+}
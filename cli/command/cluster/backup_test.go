@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiredBackupIds(t *testing.T) {
+	assert := assert.New(t)
+
+	ids := []string{"20261017-020000", "20261018-020000", "20261019-020000"}
+	assert.Equal([]string{}, expiredBackupIds(ids, 0))
+	assert.Equal([]string{}, expiredBackupIds(ids, 3))
+	assert.Equal([]string{}, expiredBackupIds(ids, 5))
+	assert.Equal([]string{"20261017-020000"}, expiredBackupIds(ids, 2))
+	assert.Equal([]string{"20261017-020000", "20261018-020000"}, expiredBackupIds(ids, 1))
+}
+
+func TestPruneBackups(t *testing.T) {
+	assert := assert.New(t)
+
+	root := t.TempDir()
+	for _, id := range []string{"20261019-020000", "20261017-020000", "20261018-020000"} {
+		assert.NoError(os.MkdirAll(filepath.Join(root, id), 0755))
+		assert.NoError(os.WriteFile(filepath.Join(root, id, comm.BACKUP_MANIFEST), []byte("{}"), 0644))
+	}
+	// directory without manifest is not a backup, e.g. the failed one
+	assert.NoError(os.MkdirAll(filepath.Join(root, "20261016-020000"), 0755))
+
+	ids, err := listBackupIds(root)
+	assert.NoError(err)
+	assert.Equal([]string{"20261017-020000", "20261018-020000", "20261019-020000"}, ids)
+
+	expired, err := pruneBackups(root, 1)
+	assert.NoError(err)
+	assert.Equal([]string{"20261017-020000", "20261018-020000"}, expired)
+	ids, err = listBackupIds(root)
+	assert.NoError(err)
+	assert.Equal([]string{"20261019-020000"}, ids)
+	assert.DirExists(filepath.Join(root, "20261016-020000"))
+
+	ids, err = listBackupIds(filepath.Join(root, "not-exist"))
+	assert.NoError(err)
+	assert.Equal([]string{}, ids)
+}
+
+func TestCheckBackupId(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(checkBackupId("20261019-020000"))
+	for _, id := range []string{"../20261019-020000", "..", "a/b", "/tmp/backup", `a\b`} {
+		assert.Error(checkBackupId(id), id)
+	}
+}
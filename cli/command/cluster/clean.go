@@ -95,6 +95,7 @@ func NewCleanCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.BoolVar(&options.withoutRecycle, "no-recycle", false, "Remove data directory directly instead of recycle chunks")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -175,6 +176,7 @@ func runClean(dingocli *cli.DingoCli, options cleanOptions) error {
 	if err != nil {
 		return err
 	}
+	pb.EnableCheckpoint(CLEAN_OPERATION)
 
 	// 3) confirm by user
 	// 3) force stop
@@ -43,8 +43,13 @@ func NewClusterCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewRestartCommand(dingocli),
 		NewDeployCommand(dingocli),
 		NewUpgradeCommand(dingocli),
+		NewRollbackCommand(dingocli),
+		NewScaleOutCommand(dingocli),
+		NewScaleInCommand(dingocli),
 		NewCleanCommand(dingocli),
 		NewPrecheckCommand(dingocli),
+		NewBackupCommand(dingocli),
+		NewRestoreCommand(dingocli),
 	)
 	return cmd
 }
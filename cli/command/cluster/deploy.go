@@ -47,6 +47,8 @@ const (
 	CHECK_STORE_HEALTH         = playbook.CHECK_STORE_HEALTH
 	CREATE_META_TABLES         = playbook.CREATE_META_TABLES
 	SYNC_JAVA_OPTS             = playbook.SYNC_JAVA_OPTS
+	START_FS_CACHE             = playbook.START_FS_CACHE
+	JOIN_CACHE_GROUP           = playbook.JOIN_CACHE_GROUP
 
 	// dingodb
 	START_DINGODB_DOCUMENT = playbook.START_DINGODB_DOCUMENT
@@ -57,6 +59,7 @@ const (
 
 	// role
 	ROLE_FS_MDS           = topology.ROLE_FS_MDS
+	ROLE_FS_CACHE         = topology.ROLE_FS_CACHE
 	ROLE_COORDINATOR      = topology.ROLE_COORDINATOR
 	ROLE_STORE            = topology.ROLE_STORE
 	ROLE_DINGODB_DOCUMENT = topology.ROLE_DINGODB_DOCUMENT
@@ -67,6 +70,19 @@ const (
 	ROLE_DINGODB_WEB      = topology.ROLE_DINGODB_WEB
 	ROLE_DINGODB_PROXY    = topology.ROLE_DINGODB_PROXY
 	ROLE_ALT              = "ALT"
+
+	// operation persisted by playbook checkpoint
+	DEPLOY_OPERATION    = "deploy"
+	START_OPERATION     = "start"
+	STOP_OPERATION      = "stop"
+	RESTART_OPERATION   = "restart"
+	CLEAN_OPERATION     = "clean"
+	UPGRADE_OPERATION   = "upgrade"
+	ROLLBACK_OPERATION  = "rollback"
+	SCALE_OUT_OPERATION = "scale-out"
+	SCALE_IN_OPERATION  = "scale-in"
+	BACKUP_OPERATION    = "backup"
+	RESTORE_OPERATION   = "restore"
 )
 
 var (
@@ -81,6 +97,12 @@ var (
 		START_MDSV2,
 	}
 
+	// cache nodes are started after mds and then join their cache group
+	DINGOFS_CACHE_DEPLOY_STEPS = []int{
+		START_FS_CACHE,
+		JOIN_CACHE_GROUP,
+	}
+
 	DINGOFS_MDSV2_FOLLOW_DEPLOY_STEPS = []int{
 		CLEAN_PRECHECK_ENVIRONMENT,
 		PULL_IMAGE,
@@ -140,6 +162,8 @@ var (
 		CREATE_META_TABLES:         ROLE_MDSV2_CLI,
 		CREATE_MDSV2_CLI_CONTAINER: ROLE_MDSV2_CLI,
 		SYNC_JAVA_OPTS:             ROLE_DINGODB_EXECUTOR,
+		START_FS_CACHE:             ROLE_FS_CACHE,
+		JOIN_CACHE_GROUP:           ROLE_FS_CACHE,
 	}
 
 	// DEPLOY_LIMIT_SERVICE is used to limit the number of services
@@ -162,6 +186,7 @@ type deployOptions struct {
 	poolset         string
 	poolsetDiskType string
 	useLocalImage   bool
+	resume          bool
 }
 
 func checkDeployOptions(options deployOptions) error {
@@ -197,7 +222,9 @@ func NewDeployCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.poolset, "poolset", "default", "Specify the poolset name")
 	flags.StringVar(&options.poolsetDiskType, "poolset-disktype", "ssd", "Specify the disk type of physical pool")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	flags.BoolVar(&options.resume, "resume", false, "Resume the last failed deploy and skip the tasks which already succeeded")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -224,8 +251,8 @@ func skipDeploySteps(dcs []*topology.DeployConfig, deploySteps []int, options de
 func precheckBeforeDeploy(dingocli *cli.DingoCli,
 	dcs []*topology.DeployConfig,
 	options deployOptions) error {
-	// 1) skip precheck
-	if options.insecure {
+	// 1) skip precheck, services of the resumed deploy may be already running
+	if options.insecure || options.resume {
 		return nil
 	}
 
@@ -271,6 +298,9 @@ func genDeployPlaybook(dingocli *cli.DingoCli,
 		} else if utils.ContainsList(roles, []string{topology.ROLE_FS_MDS, topology.ROLE_FS_MDS_CLI}) {
 			steps = DINGOFS_MDSV2_ONLY_DEPLOY_STEPS
 		}
+		if utils.Contains(roles, topology.ROLE_FS_CACHE) {
+			steps = append(append([]int{}, steps...), DINGOFS_CACHE_DEPLOY_STEPS...)
+		}
 	case topology.KIND_DINGOSTORE:
 		steps = DINGOSTORE_DEPLOY_STEPS
 	case topology.KIND_DINGODB:
@@ -342,6 +372,9 @@ func serviceStats(dingocli *cli.DingoCli, dcs []*topology.DeployConfig) string {
 			nmds = count[topology.ROLE_FS_MDS]
 			nexecutor := count[topology.ROLE_DINGODB_EXECUTOR]
 			serviceStats = fmt.Sprintf("coordinator*%d, store*%d, mds*%d, executor*%d", ncoordinator, nstore, nmds, nexecutor)
+			if ncache := count[topology.ROLE_FS_CACHE]; ncache > 0 {
+				serviceStats = fmt.Sprintf("%s, cache*%d", serviceStats, ncache)
+			}
 		} else {
 			// mds v1
 			serviceStats = fmt.Sprintf("etcd*%d, mds*%d, metaserver*%d", netcd, nmds, nmetaserver)
@@ -398,15 +431,24 @@ func runDeploy(dingocli *cli.DingoCli, options deployOptions) error {
 		return err
 	}
 
-	// 5) display title
+	// 5) persist the run, or resume the last failed one
+	if options.resume {
+		if err := pb.Resume(DEPLOY_OPERATION); err != nil {
+			return err
+		}
+	} else {
+		pb.EnableCheckpoint(DEPLOY_OPERATION)
+	}
+
+	// 6) display title
 	displayDeployTitle(dingocli, dcs)
 
-	// 6) run playground
+	// 7) run playground
 	if err = pb.Run(); err != nil {
 		return err
 	}
 
-	// 7) print success prompt
+	// 8) print success prompt
 	dingocli.WriteOutln("")
 	dingocli.WriteOutln(color.GreenString("Cluster '%s' successfully deployed ^_^."), dingocli.ClusterName())
 	return nil
@@ -41,6 +41,7 @@ const (
 const (
 	CHECK_ITEM_TOPOLOGY   = "topology"
 	CHECK_ITEM_SSH        = "ssh"
+	CHECK_ITEM_ENGINE     = "engine"
 	CHECK_ITEM_PERMISSION = "permission"
 	CHECK_ITEM_KERNEL     = "kernel"
 	CHECK_ITEM_NERWORK    = "network"
@@ -52,6 +53,7 @@ var (
 	DINGOFS_PRECHECK_STEPS = []int{
 		playbook.CHECK_TOPOLOGY,             // topology
 		playbook.CHECK_SSH_CONNECT,          // ssh
+		playbook.CHECK_CONTAINER_ENGINE,     // engine
 		playbook.CHECK_PERMISSION,           // permission
 		playbook.CLEAN_PRECHECK_ENVIRONMENT, // <none>
 		playbook.CHECK_PORT_IN_USE,          // network
@@ -69,6 +71,7 @@ var (
 	BELONG_CHECK_ITEM = map[int]string{
 		playbook.CHECK_TOPOLOGY:              CHECK_ITEM_TOPOLOGY,
 		playbook.CHECK_SSH_CONNECT:           CHECK_ITEM_SSH,
+		playbook.CHECK_CONTAINER_ENGINE:      CHECK_ITEM_ENGINE,
 		playbook.CHECK_PERMISSION:            CHECK_ITEM_PERMISSION,
 		playbook.CHECK_KERNEL_VERSION:        CHECK_ITEM_KERNEL,
 		playbook.CHECK_PORT_IN_USE:           CHECK_ITEM_NERWORK,
@@ -82,6 +85,7 @@ var (
 	CHECK_ITEMS = []string{
 		CHECK_ITEM_TOPOLOGY,
 		CHECK_ITEM_SSH,
+		CHECK_ITEM_ENGINE,
 		CHECK_ITEM_PERMISSION,
 		CHECK_ITEM_KERNEL,
 		CHECK_ITEM_NERWORK,
@@ -129,6 +133,7 @@ func NewPrecheckCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
 	//flags.StringSliceVar(&options.only, "only", CHECK_ITEMS, usage)
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
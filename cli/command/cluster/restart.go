@@ -63,6 +63,7 @@ func NewRestartCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -86,6 +87,7 @@ func genRestartPlaybook(dingocli *cli.DingoCli,
 			Configs: dcs,
 		})
 	}
+	addJoinCacheGroupStep(dingocli, pb, dcs)
 	return pb, nil
 }
 
@@ -101,6 +103,7 @@ func runRestart(dingocli *cli.DingoCli, options restartOptions) error {
 	if err != nil {
 		return err
 	}
+	pb.EnableCheckpoint(RESTART_OPERATION)
 
 	// 3) force restart
 	if options.force {
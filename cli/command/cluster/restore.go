@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	RESTORE_EXAMPLE = `Examples:
+  $ dingo cluster restore                          # Restore meta of current cluster from the latest backup
+  $ dingo cluster restore --id 20261019-020000     # Restore meta of current cluster from the specified backup`
+)
+
+var (
+	// mds services are stopped while restoring meta
+	RESTORE_PLAYBOOK_STEPS = []int{
+		playbook.STOP_SERVICE,
+		playbook.RESTORE_MDS_META,
+		playbook.START_FS_MDS,
+	}
+)
+
+type restoreOptions struct {
+	id     string
+	output string
+	force  bool
+}
+
+func NewRestoreCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options restoreOptions
+
+	cmd := &cobra.Command{
+		Use:     "restore [OPTIONS]",
+		Short:   "Restore cluster meta from backup",
+		Args:    cliutil.NoArgs,
+		Example: RESTORE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRestore(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.id, "id", "", "Specify backup id (default the latest backup)")
+	flags.StringVarP(&options.output, "output", "o", "", "Specify the directory which backups stored in (default ~/.dingo/data/backups)")
+	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+
+	cliutil.SupportDryRun(cmd)
+	return cmd
+}
+
+// the backup id is the name of directory under backup root, e.g. 20261019-020000
+func checkBackupId(id string) error {
+	if strings.ContainsAny(id, `/\`) || strings.Contains(id, "..") {
+		return errno.ERR_INVALID_BACKUP_ID.
+			F("--id: %s, requires the id listed by 'dingo cluster backup --list'", id)
+	}
+	return nil
+}
+
+func getRestoreBackup(dingocli *cli.DingoCli, options restoreOptions) (string, *task.BackupManifest, error) {
+	root := getBackupRoot(dingocli, options.output)
+	id := options.id
+	if len(id) == 0 {
+		ids, err := listBackupIds(root)
+		if err != nil {
+			return "", nil, errno.ERR_BACKUP_NOT_FOUND.E(err)
+		} else if len(ids) == 0 {
+			return "", nil, errno.ERR_BACKUP_NOT_FOUND.F("no backup in %s", root)
+		}
+		id = ids[len(ids)-1]
+	} else if err := checkBackupId(id); err != nil {
+		return "", nil, err
+	}
+
+	dir := filepath.Join(root, id)
+	if !cliutil.IsFileExists(filepath.Join(dir, comm.BACKUP_MANIFEST)) {
+		return "", nil, errno.ERR_BACKUP_NOT_FOUND.F("backup id: %s", id)
+	}
+	manifest, err := task.ReadBackupManifest(dir)
+	if err != nil {
+		return "", nil, err
+	} else if manifest.Kind != comm.BACKUP_KIND_MDSMETA {
+		// etcd snapshot restore rebuilds the data directory of every member
+		return "", nil, errno.ERR_UNSUPPORT_RESTORE_BACKUP_KIND.
+			F("kind: %s, please restore it by `etcdctl snapshot restore` manually", manifest.Kind)
+	} else if err := task.VerifyBackup(dir, manifest); err != nil {
+		return "", nil, err
+	}
+	return dir, manifest, nil
+}
+
+func genRestorePlaybook(dingocli *cli.DingoCli,
+	dcs []*topology.DeployConfig,
+	file string) (*playbook.Playbook, error) {
+	mdsDcs := dingocli.FilterDeployConfigByRole(dcs, topology.ROLE_FS_MDS)
+	cliDcs := dingocli.FilterDeployConfigByRole(dcs, topology.ROLE_FS_MDS_CLI)
+	if len(cliDcs) == 0 {
+		return nil, errno.ERR_NO_BACKUP_TARGET_IN_TOPOLOGY.
+			F("role: %s", topology.ROLE_FS_MDS_CLI)
+	}
+
+	pb := playbook.NewPlaybook(dingocli)
+	for _, step := range RESTORE_PLAYBOOK_STEPS {
+		configs := mdsDcs
+		if step == playbook.RESTORE_MDS_META {
+			configs = cliDcs[:1]
+		}
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: configs,
+			Options: map[string]interface{}{
+				comm.KEY_RESTORE_FILE: file,
+			},
+		})
+	}
+	return pb, nil
+}
+
+func runRestore(dingocli *cli.DingoCli, options restoreOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) find backup and verify its checksum
+	dir, manifest, err := getRestoreBackup(dingocli, options)
+	if err != nil {
+		return err
+	}
+
+	// 3) generate restore playbook
+	pb, err := genRestorePlaybook(dingocli, dcs, filepath.Join(dir, manifest.File))
+	if err != nil {
+		return err
+	}
+	pb.EnableCheckpoint(RESTORE_OPERATION)
+
+	// 4) confirm by user
+	if !options.force {
+		pass := tui.ConfirmYes(tui.PromptRestoreCluster(dingocli.ClusterName(), manifest.Id))
+		if !pass {
+			dingocli.WriteOut(tui.PromptCancelOpetation("restore cluster"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 5) run playbook, mds services may be left stopped if it failed
+	if err := pb.Run(); err != nil {
+		dingocli.WriteOutln(color.YellowString("Restore cluster '%s' failed, mds services may be stopped, "+
+			"retry it by 'dingo cluster restore --id %s' or start mds with current meta by 'dingo cluster start --role %s'",
+			dingocli.ClusterName(), manifest.Id, topology.ROLE_FS_MDS))
+		return err
+	}
+
+	dingocli.WriteOutln(color.GreenString("Cluster '%s' successfully restored from backup '%s' ^_^."),
+		dingocli.ClusterName(), manifest.Id)
+	return nil
+}
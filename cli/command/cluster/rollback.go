@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+type rollbackOptions struct {
+	id    string
+	role  string
+	host  string
+	force bool
+}
+
+func NewRollbackCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options rollbackOptions
+
+	cmd := &cobra.Command{
+		Use:   "rollback [OPTIONS]",
+		Short: "Rollback upgraded services to the image before upgrade",
+		Args:  cliutil.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return checkCommonOptions(dingocli, options.id, options.role, options.host)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRollback(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.id, "id", "*", "Specify service id")
+	flags.StringVar(&options.role, "role", "*", "Specify service role")
+	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+
+	cliutil.SupportDryRun(cmd)
+	return cmd
+}
+
+// services which have upgrade record, the image is replaced by the recorded one
+func genRollbackConfigs(dingocli *cli.DingoCli, dcs []*topology.DeployConfig) ([]*topology.DeployConfig, error) {
+	rollbackDcs := []*topology.DeployConfig{}
+	for _, dc := range dcs {
+		record, err := task.GetUpgradeRecord(dingocli, dingocli.GetServiceId(dc.GetId()))
+		if err != nil {
+			return nil, err
+		} else if record == nil {
+			continue
+		}
+
+		dingocli.WriteOutln("  + host=%s  role=%s  image=%s -> %s",
+			dc.GetHost(), dc.GetRole(), dc.GetContainerImage(), record.Image)
+		dc.SetContainerImage(record.Image)
+		rollbackDcs = append(rollbackDcs, dc)
+	}
+	return rollbackDcs, nil
+}
+
+// recreate services from the recorded image with the upgrade pipeline
+func rollbackServices(dingocli *cli.DingoCli, dcs []*topology.DeployConfig) error {
+	pb, err := genUpgradePlaybook(dingocli, dcs, upgradeOptions{
+		id:       "*",
+		role:     "*",
+		host:     "*",
+		rollback: true,
+	})
+	if err != nil {
+		return err
+	}
+	pb.EnableCheckpoint(ROLLBACK_OPERATION)
+	if err := pb.Run(); err != nil {
+		return err
+	}
+
+	for _, dc := range dcs {
+		err := dingocli.Storage().DeleteUpgradeRecord(dingocli.GetServiceId(dc.GetId()))
+		if err != nil {
+			return errno.ERR_DELETE_UPGRADE_RECORD_FAILED.E(err)
+		}
+	}
+	return nil
+}
+
+// rollback the services of failed batch in rolling upgrade, the cause is always returned
+func rollbackFailedBatch(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, cause error) error {
+	dingocli.WriteOutln("")
+	dingocli.WriteOutln(color.YellowString("Rollback services of the failed batch:"))
+	rollbackDcs, err := genRollbackConfigs(dingocli, dcs)
+	if err == nil && len(rollbackDcs) > 0 {
+		err = rollbackServices(dingocli, rollbackDcs)
+	}
+
+	if err != nil {
+		dingocli.WriteOutln(color.RedString("Rollback failed: %s", err))
+	} else if len(rollbackDcs) == 0 {
+		dingocli.WriteOutln(color.YellowString("No upgrade record, skip rollback"))
+	} else {
+		dingocli.WriteOutln(color.GreenString("Rollback %d services success", len(rollbackDcs)))
+	}
+	return cause
+}
+
+func runRollback(dingocli *cli.DingoCli, options rollbackOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) filter deploy config
+	dcs = dingocli.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:   options.id,
+		Role: options.role,
+		Host: options.host,
+	})
+	if len(dcs) == 0 {
+		return errno.ERR_NO_SERVICES_MATCHED
+	}
+
+	// 3) replace image by upgrade record
+	dingocli.WriteOutln(color.YellowString("Rollback services:"))
+	dcs, err = genRollbackConfigs(dingocli, dcs)
+	if err != nil {
+		return err
+	} else if len(dcs) == 0 {
+		return errno.ERR_NO_UPGRADE_RECORD
+	}
+
+	// 4) confirm by user
+	if !options.force {
+		if pass := tui.ConfirmYes(tui.DEFAULT_CONFIRM_PROMPT); !pass {
+			dingocli.WriteOut(tui.PromptCancelOpetation("rollback service"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 5) recreate services
+	if err := rollbackServices(dingocli, dcs); err != nil {
+		return err
+	}
+
+	// 6) print success prompt
+	dingocli.WriteOutln("")
+	dingocli.WriteOutln(color.GreenString("Rollback %d services success :)", len(dcs)))
+	return nil
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	"github.com/dingodb/dingocli/internal/rpc"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	"github.com/fatih/color"
+)
+
+const (
+	DEFAULT_HEALTH_TIMEOUT  = 5 * time.Minute
+	HEALTH_CHECK_INTERVAL   = 5 * time.Second
+	HEALTH_CHECK_RPCTIMEOUT = 3 * time.Second
+)
+
+// split services into batches, roles are upgraded in the order of topology
+// and at most maxUnavailable services of one role are upgraded at a time
+func splitRollingBatches(roles []string, maxUnavailable int) [][]int {
+	order := []string{}
+	group := map[string][]int{}
+	for i, role := range roles {
+		if _, ok := group[role]; !ok {
+			order = append(order, role)
+		}
+		group[role] = append(group[role], i)
+	}
+
+	batches := [][]int{}
+	for _, role := range order {
+		indexes := group[role]
+		for len(indexes) > 0 {
+			n := min(maxUnavailable, len(indexes))
+			batches = append(batches, indexes[:n])
+			indexes = indexes[n:]
+		}
+	}
+	return batches
+}
+
+// check container running and ports listening
+func checkServicesRunning(dingocli *cli.DingoCli, dcs []*topology.DeployConfig) error {
+	pb := playbook.NewPlaybook(dingocli)
+	for _, step := range GET_STATUS_PLAYBOOK_STEPS {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: dcs,
+			ExecOptions: playbook.ExecOptions{
+				SilentSubBar:  true,
+				SilentMainBar: true,
+				SkipError:     true,
+			},
+		})
+	}
+	if err := pb.Run(); err != nil {
+		return err
+	}
+
+	statuses := map[string]task.ServiceStatus{}
+	if value := dingocli.MemStorage().Get(comm.KEY_ALL_SERVICE_STATUS); value != nil {
+		statuses = value.(map[string]task.ServiceStatus)
+	}
+	for _, dc := range dcs {
+		status, ok := statuses[dingocli.GetServiceId(dc.GetId())]
+		if !ok || !strings.HasPrefix(status.Status, "Up") {
+			return fmt.Errorf("container of %s/%s is not running", dc.GetHost(), dc.GetId())
+		} else if len(status.Ports) == 0 {
+			return fmt.Errorf("%s/%s is not listening on any port", dc.GetHost(), dc.GetId())
+		}
+	}
+	return nil
+}
+
+// check upgraded mds are online in mds list
+func checkMdsOnline(dcs []*topology.DeployConfig) error {
+	mdsAddr := getClusterMdsAddr(dcs)
+	if mdsAddr == "-" {
+		return nil
+	}
+
+	mdses, err := rpc.GetMDSListWithEndPoint(strings.Split(mdsAddr, ","), HEALTH_CHECK_RPCTIMEOUT)
+	if err != nil {
+		return err
+	}
+
+	online := map[string]bool{}
+	for _, mds := range mdses {
+		location := mds.GetLocation()
+		online[fmt.Sprintf("%s:%d", location.GetHost(), location.GetPort())] = mds.GetIsOnline()
+	}
+	for _, dc := range dcs {
+		addr := fmt.Sprintf("%s:%d", dc.GetListenIp(), dc.GetListenPort())
+		if !online[addr] {
+			return fmt.Errorf("mds %s/%s (%s) is not online", dc.GetHost(), dc.GetId(), addr)
+		}
+	}
+	return nil
+}
+
+// check store cluster health by the script in store container
+func checkStoreHealth(dingocli *cli.DingoCli, dcs []*topology.DeployConfig) error {
+	dingocli.MemStorage().Set(comm.KEY_STORE_HEALTH, false)
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.CHECK_STORE_HEALTH,
+		Configs: dcs[:1],
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar:  true,
+			SilentMainBar: true,
+		},
+	})
+	if err := pb.Run(); err != nil {
+		return err
+	}
+
+	if healthy, ok := dingocli.MemStorage().Get(comm.KEY_STORE_HEALTH).(bool); !ok || !healthy {
+		return fmt.Errorf("store cluster is not healthy")
+	}
+	return nil
+}
+
+func checkServicesHealth(dingocli *cli.DingoCli, dcs []*topology.DeployConfig) error {
+	// mds-client is a temporary role without running service
+	services := []*topology.DeployConfig{}
+	for _, dc := range dcs {
+		if dc.GetRole() != topology.ROLE_FS_MDS_CLI {
+			services = append(services, dc)
+		}
+	}
+	if len(services) == 0 {
+		return nil
+	}
+	dcs = services
+
+	if err := checkServicesRunning(dingocli, dcs); err != nil {
+		return err
+	}
+	if mdsDcs := dingocli.FilterDeployConfigByRole(dcs, topology.ROLE_FS_MDS); len(mdsDcs) > 0 {
+		if err := checkMdsOnline(mdsDcs); err != nil {
+			return err
+		}
+	}
+	if storeDcs := dingocli.FilterDeployConfigByRole(dcs, topology.ROLE_STORE); len(storeDcs) > 0 {
+		if err := checkStoreHealth(dingocli, storeDcs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wait until the upgraded services pass all health gates or timeout
+func waitServicesHealthy(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, timeout time.Duration) error {
+	// services are not really upgraded in dry-run
+	if dingocli.DryRun() {
+		return nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		err := checkServicesHealth(dingocli, dcs)
+		if err == nil {
+			return nil
+		} else if time.Now().After(deadline) {
+			return errno.ERR_SERVICE_NOT_HEALTHY_AFTER_UPGRADE.E(err)
+		}
+		time.Sleep(HEALTH_CHECK_INTERVAL)
+	}
+}
+
+func upgradeRolling(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, options upgradeOptions) error {
+	// 1) display upgrade title
+	displayTitle(dingocli, dcs, options)
+
+	// 2) upgrade services batch by batch, stop the rollout on the first failure
+	roles := []string{}
+	for _, dc := range dcs {
+		roles = append(roles, dc.GetRole())
+	}
+	batches := splitRollingBatches(roles, options.maxUnavailable)
+	for i, batch := range batches {
+		batchDcs := []*topology.DeployConfig{}
+		for _, index := range batch {
+			batchDcs = append(batchDcs, dcs[index])
+		}
+
+		// 2.1) display services in batch
+		dingocli.WriteOutln("")
+		dingocli.WriteOutln("Upgrade batch %s:", color.BlueString("%d/%d", i+1, len(batches)))
+		for _, dc := range batchDcs {
+			dingocli.WriteOutln("  + host=%s  role=%s  image=%s", dc.GetHost(), dc.GetRole(), dc.GetContainerImage())
+		}
+
+		// 2.2) generate and run upgrade playbook
+		pb, err := genUpgradePlaybook(dingocli, batchDcs, options)
+		if err != nil {
+			return err
+		}
+		pb.EnableCheckpoint(UPGRADE_OPERATION)
+		if err := pb.Run(); err != nil {
+			dingocli.WriteOutln(color.RedString("Rollout stopped at batch %d/%d", i+1, len(batches)))
+			return rollbackFailedBatch(dingocli, batchDcs, err)
+		}
+
+		// 2.3) health gates
+		dingocli.WriteOutln("")
+		dingocli.WriteOutln("Waiting for %d service(s) to become healthy (timeout %s)...", len(batchDcs), options.healthTimeout)
+		if err := waitServicesHealthy(dingocli, batchDcs, options.healthTimeout); err != nil {
+			dingocli.WriteOutln(color.RedString("Rollout stopped at batch %d/%d", i+1, len(batches)))
+			return rollbackFailedBatch(dingocli, batchDcs, err)
+		}
+		dingocli.WriteOutln(color.GreenString("Upgrade batch %d/%d success :)", i+1, len(batches)))
+	}
+
+	// 3) print success prompt
+	dingocli.WriteOutln("")
+	dingocli.WriteOutln(color.GreenString("Upgrade %d services success :)", len(dcs)))
+	return nil
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitRollingBatches(t *testing.T) {
+	assert := assert.New(t)
+
+	roles := []string{"coordinator", "coordinator", "coordinator", "store", "store", "mds", "coordinator"}
+	assert.Equal([][]int{{0}, {1}, {2}, {6}, {3}, {4}, {5}}, splitRollingBatches(roles, 1))
+	assert.Equal([][]int{{0, 1}, {2, 6}, {3, 4}, {5}}, splitRollingBatches(roles, 2))
+	assert.Equal([][]int{{0, 1, 2, 6}, {3, 4}, {5}}, splitRollingBatches(roles, 10))
+	assert.Empty(splitRollingBatches([]string{}, 1))
+}
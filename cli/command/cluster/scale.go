@@ -0,0 +1,531 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	"github.com/dingodb/dingocli/internal/rpc"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	utils "github.com/dingodb/dingocli/internal/utils"
+	pbmds "github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	SCALE_OUT_EXAMPLE = `Examples:
+  $ dingocli cluster scale-out topology.yaml          # Deploy the services added in topology.yaml
+  $ dingocli cluster scale-out topology.yaml --local  # Deploy the added services with local image`
+
+	SCALE_IN_EXAMPLE = `Examples:
+  $ dingocli cluster scale-in topology.yaml               # Stop and remove the services deleted in topology.yaml
+  $ dingocli cluster scale-in topology.yaml --clean-data  # Also clean log and data of the removed services`
+
+	SCALE_RPCTIMEOUT = 10 * time.Second
+)
+
+var (
+	SCALE_OUT_PLAYBOOK_STEPS = []int{
+		PULL_IMAGE,
+		CREATE_CONTAINER,
+		SYNC_CONFIG,
+		playbook.START_SERVICE,
+		CHECK_STORE_HEALTH,
+		JOIN_CACHE_GROUP,
+	}
+
+	// membership of these roles is kept by raft or created once at deploy,
+	// they can't be changed by adding or removing containers
+	SCALE_DENIED_ROLES = []string{
+		topology.ROLE_ETCD,
+		ROLE_COORDINATOR,
+		ROLE_MDSV2_CLI,
+	}
+)
+
+type scaleOptions struct {
+	filename       string
+	insecure       bool
+	useLocalImage  bool
+	cleanData      bool
+	withoutRecycle bool
+	force          bool
+}
+
+func NewScaleOutCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options scaleOptions
+
+	cmd := &cobra.Command{
+		Use:     "scale-out TOPOLOGY [OPTIONS]",
+		Short:   "Scale out cluster",
+		Args:    cliutil.ExactArgs(1),
+		Example: SCALE_OUT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.filename = args[0]
+			return runScaleOut(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&options.insecure, "insecure", "k", false, "Scale out without precheck")
+	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+
+	cliutil.SupportDryRun(cmd)
+	return cmd
+}
+
+func NewScaleInCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options scaleOptions
+
+	cmd := &cobra.Command{
+		Use:     "scale-in TOPOLOGY [OPTIONS]",
+		Short:   "Scale in cluster",
+		Args:    cliutil.ExactArgs(1),
+		Example: SCALE_IN_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.filename = args[0]
+			return runScaleIn(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&options.cleanData, "clean-data", false, "Clean log and data of removed services besides container")
+	flags.BoolVar(&options.withoutRecycle, "no-recycle", false, "Remove data directory directly instead of recycle chunks")
+	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+
+	cliutil.SupportDryRun(cmd)
+	return cmd
+}
+
+func readScaleTopology(dingocli *cli.DingoCli, filename string) (string, error) {
+	if !utils.PathExist(filename) {
+		return "", errno.ERR_TOPOLOGY_FILE_NOT_FOUND.
+			F("%s: no such file", utils.AbsPath(filename))
+	}
+
+	data, err := utils.ReadFile(filename)
+	if err != nil {
+		return "", errno.ERR_READ_TOPOLOGY_FILE_FAILED.E(err)
+	}
+
+	dingocli.WriteOutln("%s", utils.Diff(dingocli.ClusterTopologyData(), data))
+	return data, nil
+}
+
+// diff the committed topology with the new one, returns added and deleted services
+func diffScaleTopology(dingocli *cli.DingoCli, data string) ([]*topology.DeployConfig, []*topology.DeployConfig, error) {
+	diffs, err := dingocli.DiffTopology(dingocli.ClusterTopologyData(), data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	added := []*topology.DeployConfig{}
+	deleted := []*topology.DeployConfig{}
+	for _, diff := range diffs {
+		switch diff.DiffType {
+		case topology.DIFF_ADD:
+			added = append(added, diff.DeployConfig)
+		case topology.DIFF_DELETE:
+			deleted = append(deleted, diff.DeployConfig)
+		}
+	}
+	return added, deleted, nil
+}
+
+func checkScaleRoles(dcs []*topology.DeployConfig) error {
+	for _, dc := range dcs {
+		if utils.Contains(SCALE_DENIED_ROLES, dc.GetRole()) {
+			return errno.ERR_UNSUPPORT_ROLE_FOR_SCALE_CLUSTER.
+				F("service: %s.host[%s]", dc.GetRole(), dc.GetHost())
+		}
+	}
+	return nil
+}
+
+func displayScaleServices(dingocli *cli.DingoCli, operation string, dcs []*topology.DeployConfig) {
+	dingocli.WriteOutln("Cluster Name    : %s", dingocli.ClusterName())
+	dingocli.WriteOutln("Cluster Kind    : %s", dcs[0].GetKind())
+	for _, dc := range dcs {
+		dingocli.WriteOutln("%s %s", operation, color.BlueString("%s.host[%s]", dc.GetRole(), dc.GetHost()))
+	}
+	dingocli.WriteOutln("")
+}
+
+func precheckBeforeScaleOut(dingocli *cli.DingoCli,
+	dcs []*topology.DeployConfig,
+	options scaleOptions) error {
+	if options.insecure {
+		return nil
+	}
+
+	// the topology item checks the whole cluster, it is done by diff and parse
+	pb, err := genPrecheckPlaybook(dingocli, dcs, precheckOptions{
+		skip:          []string{CHECK_ITEM_TOPOLOGY},
+		useLocalImage: options.useLocalImage,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = pb.Run()
+	if err != nil {
+		return err
+	}
+
+	dingocli.WriteOutln("")
+	dingocli.WriteOutln(color.GreenString("Congratulations!!! all precheck passed :)"))
+	dingocli.WriteOutln("")
+	return nil
+}
+
+func genScaleOutPlaybook(dingocli *cli.DingoCli,
+	dcs []*topology.DeployConfig,
+	options scaleOptions) *playbook.Playbook {
+	pb := playbook.NewPlaybook(dingocli)
+	for _, step := range SCALE_OUT_PLAYBOOK_STEPS {
+		if step == PULL_IMAGE && options.useLocalImage {
+			continue
+		}
+
+		config := dcs
+		if len(DEPLOY_FILTER_ROLE[step]) > 0 {
+			config = dingocli.FilterDeployConfigByRole(config, DEPLOY_FILTER_ROLE[step])
+		}
+		if len(config) == 0 {
+			continue
+		} else if n := DEPLOY_LIMIT_SERVICE[step]; n > 0 && len(config) > n {
+			config = config[:n]
+		}
+
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: config,
+		})
+	}
+	return pb
+}
+
+func updateScaleTopology(dingocli *cli.DingoCli, data string) error {
+	err := dingocli.Storage().SetClusterTopology(dingocli.ClusterId(), data)
+	if err != nil {
+		return errno.ERR_UPDATE_CLUSTER_TOPOLOGY_FAILED.E(err)
+	}
+	return nil
+}
+
+func runScaleOut(dingocli *cli.DingoCli, options scaleOptions) error {
+	// 1) parse cluster topology
+	_, err := dingocli.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) read new topology and diff it
+	data, err := readScaleTopology(dingocli, options.filename)
+	if err != nil {
+		return err
+	}
+	added, deleted, err := diffScaleTopology(dingocli, data)
+	if err != nil {
+		return err
+	} else if len(deleted) > 0 {
+		return errno.ERR_DELETE_SERVICE_WHILE_SCALE_OUT_CLUSTER_IS_DENIED.
+			F("deleted service: %s.host[%s]", deleted[0].GetRole(), deleted[0].GetHost())
+	} else if len(added) == 0 {
+		return errno.ERR_NO_SERVICES_FOR_SCALE_OUT_CLUSTER
+	} else if err = checkScaleRoles(added); err != nil {
+		return err
+	}
+
+	// 3) confirm by user
+	displayScaleServices(dingocli, "Add", added)
+	if !options.force {
+		if pass := tui.ConfirmYes(tui.PromptScaleOut()); !pass {
+			dingocli.WriteOutln(tui.PromptCancelOpetation("scale out cluster"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 4) precheck the added services
+	err = precheckBeforeScaleOut(dingocli, added, options)
+	if err != nil {
+		return err
+	}
+
+	// 5) deploy the added services
+	pb := genScaleOutPlaybook(dingocli, added, options)
+	pb.EnableCheckpoint(SCALE_OUT_OPERATION)
+	err = pb.Run()
+	if err != nil {
+		return err
+	}
+
+	// 6) update cluster topology
+	err = updateScaleTopology(dingocli, data)
+	if err != nil {
+		return err
+	}
+
+	dingocli.WriteOutln(color.GreenString("Cluster '%s' successfully scaled out ^_^."), dingocli.ClusterName())
+	return nil
+}
+
+// the remaining stores must hold at least one copy of every region,
+// so the removed stores must be fewer than the replica num
+func checkStoreReplicas(total, removed, replicaNum int) error {
+	if replicaNum <= 0 {
+		replicaNum = 1
+	}
+	if removed >= replicaNum {
+		return errno.ERR_STORE_REPLICA_NOT_ENOUGH_WHILE_SCALE_IN.
+			F("remove %d stores at once while replica num is %d", removed, replicaNum)
+	} else if total-removed < replicaNum {
+		return errno.ERR_STORE_REPLICA_NOT_ENOUGH_WHILE_SCALE_IN.
+			F("%d stores left while replica num is %d", total-removed, replicaNum)
+	}
+	return nil
+}
+
+func excludeDeployConfigs(dcs, excluded []*topology.DeployConfig) []*topology.DeployConfig {
+	ids := map[string]bool{}
+	for _, dc := range excluded {
+		ids[dc.GetId()] = true
+	}
+
+	out := []*topology.DeployConfig{}
+	for _, dc := range dcs {
+		if !ids[dc.GetId()] {
+			out = append(out, dc)
+		}
+	}
+	return out
+}
+
+// the removed mds must not serve any partition of the filesystems
+func checkMdsPartitions(dcs, removed []*topology.DeployConfig) error {
+	mdsAddr := getClusterMdsAddr(dcs)
+	if mdsAddr == "-" {
+		return nil
+	}
+	endpoints := strings.Split(mdsAddr, ",")
+
+	mdses, err := rpc.GetMDSListWithEndPoint(endpoints, SCALE_RPCTIMEOUT)
+	if err != nil {
+		return err
+	}
+	addrs := map[string]*topology.DeployConfig{}
+	for _, dc := range removed {
+		addrs[fmt.Sprintf("%s:%d", dc.GetListenIp(), dc.GetListenPort())] = dc
+	}
+	owners := map[int64]*topology.DeployConfig{}
+	for _, mds := range mdses {
+		location := mds.GetLocation()
+		if dc, ok := addrs[fmt.Sprintf("%s:%d", location.GetHost(), location.GetPort())]; ok {
+			owners[mds.GetId()] = dc
+		}
+	}
+	if len(owners) == 0 {
+		return nil
+	}
+
+	fsInfos, err := rpc.ListFsInfoWithEndPoint(endpoints, SCALE_RPCTIMEOUT)
+	if err != nil {
+		return err
+	}
+	for _, fsInfo := range fsInfos {
+		policy := fsInfo.GetPartitionPolicy()
+		mdsIds := []int64{}
+		switch policy.GetType() {
+		case pbmds.PartitionType_MONOLITHIC_PARTITION:
+			mdsIds = append(mdsIds, int64(policy.GetMono().GetMdsId()))
+		case pbmds.PartitionType_PARENT_ID_HASH_PARTITION:
+			for mdsId, bucketSet := range policy.GetParentHash().GetDistributions() {
+				if len(bucketSet.GetBucketIds()) > 0 {
+					mdsIds = append(mdsIds, int64(mdsId))
+				}
+			}
+		}
+
+		for _, mdsId := range mdsIds {
+			if dc, ok := owners[mdsId]; ok {
+				return errno.ERR_MDS_OWNS_FS_PARTITION_WHILE_SCALE_IN.
+					F("fs %s is served by mds %s.host[%s]", fsInfo.GetFsName(), dc.GetRole(), dc.GetHost())
+			}
+		}
+	}
+	return nil
+}
+
+func checkBeforeScaleIn(dingocli *cli.DingoCli, dcs, removed []*topology.DeployConfig) error {
+	// 1) mds: partitions must be moved away
+	if mdses := dingocli.FilterDeployConfigByRole(removed, ROLE_FS_MDS); len(mdses) > 0 {
+		if err := checkMdsPartitions(dcs, mdses); err != nil {
+			return err
+		}
+	}
+
+	// 2) store: never remove the last copy and keep the remaining healthy
+	if stores := dingocli.FilterDeployConfigByRole(removed, ROLE_STORE); len(stores) > 0 {
+		all := dingocli.FilterDeployConfigByRole(dcs, ROLE_STORE)
+		err := checkStoreReplicas(len(all), len(stores), all[0].GetDingoStoreReplicaNum())
+		if err != nil {
+			return err
+		}
+		// the health check task is only recorded in dry-run
+		if dingocli.DryRun() {
+			return nil
+		}
+		err = checkStoreHealth(dingocli, excludeDeployConfigs(all, stores))
+		if err != nil {
+			return errno.ERR_STORE_REPLICA_NOT_ENOUGH_WHILE_SCALE_IN.E(err)
+		}
+	}
+	return nil
+}
+
+// the removed cache nodes leave their groups before stopped, so clients
+// stop routing blocks to them
+func leaveCacheGroups(dingocli *cli.DingoCli, dcs, removed []*topology.DeployConfig) error {
+	mdsAddr := getClusterMdsAddr(dcs)
+	if mdsAddr == "-" {
+		return nil
+	}
+	endpoints := strings.Split(mdsAddr, ",")
+
+	for _, dc := range removed {
+		members, err := rpc.ListCacheMembersWithEndPoint(endpoints, SCALE_RPCTIMEOUT, dc.GetCacheGroup())
+		if err != nil {
+			return errno.ERR_LEAVE_CACHE_GROUP_FAILED.E(err)
+		}
+		for _, member := range members {
+			if member.GetIp() != dc.GetListenIp() || member.GetPort() != uint32(dc.GetListenPort()) {
+				continue
+			}
+
+			if dingocli.DryRun() {
+				dingocli.WriteOutln("Dry run: cache member %s will leave group %s", member.GetMemberId(), dc.GetCacheGroup())
+				continue
+			}
+			err := rpc.LeaveCacheMemberWithEndPoint(endpoints, SCALE_RPCTIMEOUT,
+				dc.GetCacheGroup(), member.GetMemberId(), member.GetIp(), member.GetPort())
+			dingocli.RecordAudit(err, "cluster scale-in: cache member %s leave group %s",
+				member.GetMemberId(), dc.GetCacheGroup())
+			if err != nil {
+				return errno.ERR_LEAVE_CACHE_GROUP_FAILED.
+					F("%s.host[%s] member=%s: %v", dc.GetRole(), dc.GetHost(), member.GetMemberId(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func genScaleInPlaybook(dingocli *cli.DingoCli,
+	dcs []*topology.DeployConfig,
+	options scaleOptions) (*playbook.Playbook, error) {
+	only := []string{comm.CLEAN_ITEM_CONTAINER}
+	if options.cleanData {
+		only = append([]string{}, CLEAN_ITEMS...)
+	}
+
+	// stop service is added before clean by the container item
+	return genCleanPlaybook(dingocli, dcs, cleanOptions{
+		id:             "*",
+		role:           "*",
+		host:           "*",
+		only:           only,
+		withoutRecycle: options.withoutRecycle,
+	})
+}
+
+func runScaleIn(dingocli *cli.DingoCli, options scaleOptions) error {
+	// 1) parse cluster topology
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return err
+	}
+
+	// 2) read new topology and diff it
+	data, err := readScaleTopology(dingocli, options.filename)
+	if err != nil {
+		return err
+	}
+	added, deleted, err := diffScaleTopology(dingocli, data)
+	if err != nil {
+		return err
+	} else if len(added) > 0 {
+		return errno.ERR_ADD_SERVICE_WHILE_SCALE_IN_CLUSTER_IS_DENIED.
+			F("added service: %s.host[%s]", added[0].GetRole(), added[0].GetHost())
+	} else if len(deleted) == 0 {
+		return errno.ERR_NO_SERVICES_FOR_SCALE_IN_CLUSTER
+	} else if err = checkScaleRoles(deleted); err != nil {
+		return err
+	}
+
+	// 3) role specified safety checks
+	err = checkBeforeScaleIn(dingocli, dcs, deleted)
+	if err != nil {
+		return err
+	}
+
+	// 4) confirm by user
+	displayScaleServices(dingocli, "Remove", deleted)
+	if !options.force {
+		if pass := tui.ConfirmYes(tui.PromptScaleIn()); !pass {
+			dingocli.WriteOutln(tui.PromptCancelOpetation("scale in cluster"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 5) leave the removed cache nodes from their groups
+	if caches := dingocli.FilterDeployConfigByRole(deleted, ROLE_FS_CACHE); len(caches) > 0 {
+		if err := leaveCacheGroups(dingocli, dcs, caches); err != nil {
+			return err
+		}
+	}
+
+	// 6) stop and clean the removed services
+	pb, err := genScaleInPlaybook(dingocli, deleted, options)
+	if err != nil {
+		return err
+	}
+	pb.EnableCheckpoint(SCALE_IN_OPERATION)
+	err = pb.Run()
+	if err != nil {
+		return err
+	}
+
+	// 7) update cluster topology
+	err = updateScaleTopology(dingocli, data)
+	if err != nil {
+		return err
+	}
+
+	dingocli.WriteOutln(color.GreenString("Cluster '%s' successfully scaled in ^_^."), dingocli.ClusterName())
+	return nil
+}
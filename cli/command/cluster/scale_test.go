@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const SCALE_IN_TOPOLOGY = `
+kind: dingo-store
+global:
+  default_replica_num: 3
+
+coordinator_services:
+  deploy:
+    - host: 10.0.0.1
+
+store_services:
+  deploy:
+    - host: 10.0.0.1
+    - host: 10.0.0.2
+    - host: 10.0.0.3
+    - host: 10.0.0.4
+`
+
+func TestCheckStoreReplicas(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(checkStoreReplicas(4, 1, 3))
+	assert.Nil(checkStoreReplicas(5, 2, 3))
+	// the last copy would be removed
+	assert.NotNil(checkStoreReplicas(6, 3, 3))
+	assert.NotNil(checkStoreReplicas(2, 1, 1))
+	assert.NotNil(checkStoreReplicas(2, 1, 0))
+	// not enough stores left for the replicas
+	assert.NotNil(checkStoreReplicas(3, 1, 3))
+}
+
+func TestCheckBeforeScaleInDryRun(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	dingocli, err := cli.NewDingoCli()
+	require.NoError(t, err)
+	require.NoError(t, dingocli.SetDryRun(cli.DRY_RUN_FORMAT_TEXT))
+
+	ctx := topology.NewContext()
+	for _, host := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"} {
+		ctx.Add(host, host)
+	}
+	dcs, err := topology.ParseTopology(SCALE_IN_TOPOLOGY, ctx)
+	require.NoError(t, err)
+	stores := dingocli.FilterDeployConfigByRole(dcs, ROLE_STORE)
+	require.Len(t, stores, 4)
+
+	// the store health is not checked in dry-run, the replicas still are
+	assert.NoError(t, checkBeforeScaleIn(dingocli, dcs, stores[3:]))
+	assert.Error(t, checkBeforeScaleIn(dingocli, dcs, stores[2:]))
+}
@@ -85,6 +85,7 @@ func NewStartCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -108,9 +109,22 @@ func genStartPlaybook(dingocli *cli.DingoCli,
 			Configs: dcs,
 		})
 	}
+	addJoinCacheGroupStep(dingocli, pb, dcs)
 	return pb, nil
 }
 
+// addJoinCacheGroupStep waits the started cache nodes joined their group
+func addJoinCacheGroupStep(dingocli *cli.DingoCli, pb *playbook.Playbook, dcs []*topology.DeployConfig) {
+	caches := dingocli.FilterDeployConfigByRole(dcs, ROLE_FS_CACHE)
+	if len(caches) == 0 {
+		return
+	}
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    JOIN_CACHE_GROUP,
+		Configs: caches,
+	})
+}
+
 func runStart(dingocli *cli.DingoCli, options startOptions) error {
 	// 1) parse cluster topology
 	dcs, err := dingocli.ParseTopology()
@@ -123,6 +137,7 @@ func runStart(dingocli *cli.DingoCli, options startOptions) error {
 	if err != nil {
 		return err
 	}
+	pb.EnableCheckpoint(START_OPERATION)
 
 	// 3) force start
 	if options.force {
@@ -72,6 +72,7 @@ func NewStatusCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVarP(&options.withCluster, "with-cluster", "w", "", "Display status of specified cluster with current default cluster")
 	flags.StringVar(&options.dir, "dir", "", "Only display services which data/raft/doc/vector dirs contain specified string")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -137,7 +138,11 @@ func displayStatus(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, options
 	roles := dingocli.GetRoles(dcs)
 	isMdsv2 := dcs[0].GetCtx().Lookup(topology.CTX_KEY_MDS_VERSION) == topology.CTX_VAL_MDS_V2
 	isMdsv2Only := false
-	if utils.ContainsList(roles, []string{topology.ROLE_FS_MDS, topology.ROLE_FS_MDS_CLI}) && len(roles) == 2 {
+	nonCacheRoles := len(roles)
+	if utils.Contains(roles, topology.ROLE_FS_CACHE) {
+		nonCacheRoles-- // cache nodes can be deployed along with any mds cluster
+	}
+	if utils.ContainsList(roles, []string{topology.ROLE_FS_MDS, topology.ROLE_FS_MDS_CLI}) && nonCacheRoles == 2 {
 		isMdsv2Only = true
 		excludeCols = append(excludeCols, "Data Dir")
 	}
@@ -63,6 +63,7 @@ func NewStopCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -101,6 +102,7 @@ func runStop(dingocli *cli.DingoCli, options stopOptions) error {
 	if err != nil {
 		return err
 	}
+	pb.EnableCheckpoint(STOP_OPERATION)
 
 	// 3) force stop
 	if options.force {
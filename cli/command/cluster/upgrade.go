@@ -17,6 +17,8 @@
 package cluster
 
 import (
+	"time"
+
 	"github.com/dingodb/dingocli/cli/cli"
 	comm "github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/configure/topology"
@@ -51,15 +53,21 @@ var (
 		playbook.CHECK_STORE_HEALTH,
 		playbook.START_FS_MDS,
 		playbook.START_DINGODB_EXECUTOR,
+		playbook.START_FS_CACHE,
+		playbook.JOIN_CACHE_GROUP,
 	}
 )
 
 type upgradeOptions struct {
-	id            string
-	role          string
-	host          string
-	force         bool
-	useLocalImage bool
+	id             string
+	role           string
+	host           string
+	force          bool
+	useLocalImage  bool
+	rolling        bool
+	maxUnavailable int
+	healthTimeout  time.Duration
+	rollback       bool // recreate services from upgrade record
 }
 
 func NewUpgradeCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -70,6 +78,9 @@ func NewUpgradeCommand(dingocli *cli.DingoCli) *cobra.Command {
 		Short: "Upgrade cluster",
 		Args:  cliutil.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if options.maxUnavailable <= 0 {
+				return errno.ERR_INVALID_MAX_UNAVAILABLE.F("max-unavailable: %d", options.maxUnavailable)
+			}
 			return checkCommonOptions(dingocli, options.id, options.role, options.host)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -84,7 +95,11 @@ func NewUpgradeCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	flags.BoolVar(&options.rolling, "rolling", false, "Upgrade services batch by batch and wait until they are healthy")
+	flags.IntVar(&options.maxUnavailable, "max-unavailable", 1, "Maximum number of services of one role upgraded at a time in rolling mode")
+	flags.DurationVar(&options.healthTimeout, "health-timeout", DEFAULT_HEALTH_TIMEOUT, "Time to wait for upgraded services to become healthy in rolling mode")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -104,10 +119,19 @@ func genUpgradePlaybook(dingocli *cli.DingoCli,
 	if utils.Contains(roles, topology.ROLE_FS_MDS_CLI) {
 		// upgrade mds v2
 		steps = UPGRADE_STORE_FS_STEPS
+	} else if utils.Contains(roles, topology.ROLE_FS_CACHE) {
+		// upgraded cache nodes join their group again
+		steps = append(append([]int{}, steps...), playbook.JOIN_CACHE_GROUP)
+	}
+
+	if !options.rollback {
+		// record the image before upgrade for rollback
+		steps = append([]int{playbook.RECORD_SERVICE_IMAGE}, steps...)
 	}
 
 	if options.useLocalImage {
-		// remove PULL_IMAGE step
+		// remove PULL_IMAGE step, copy first for the playbook is generated more than once
+		steps = append([]int{}, steps...)
 		for i, item := range steps {
 			if item == PULL_IMAGE {
 				steps = append(steps[:i], steps[i+1:]...)
@@ -149,7 +173,10 @@ func genUpgradePlaybook(dingocli *cli.DingoCli,
 
 func displayTitle(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, options upgradeOptions) {
 	total := len(dcs)
-	if options.force {
+	if options.rolling {
+		dingocli.WriteOutln(color.YellowString("Upgrade %d services rolling, at most %d service(s) of one role at a time",
+			total, options.maxUnavailable))
+	} else if options.force {
 		dingocli.WriteOutln(color.YellowString("Upgrade %d services at once", total))
 	} else {
 		dingocli.WriteOutln(color.YellowString("Upgrade %d services one by one", total))
@@ -166,6 +193,7 @@ func upgradeAtOnce(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, options
 	if err != nil {
 		return err
 	}
+	pb.EnableCheckpoint(UPGRADE_OPERATION)
 
 	// 3) run playbook
 	err = pb.Run()
@@ -200,6 +228,7 @@ func upgradeOneByOne(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, optio
 		if err != nil {
 			return err
 		}
+		pb.EnableCheckpoint(UPGRADE_OPERATION)
 
 		// 2.3) run playbook
 		err = pb.Run()
@@ -231,11 +260,16 @@ func runUpgrade(dingocli *cli.DingoCli, options upgradeOptions) error {
 		return errno.ERR_NO_SERVICES_MATCHED
 	}
 
-	// 3.1) upgrade service at once
+	// 3.1) upgrade service rolling with health gates
+	if options.rolling {
+		return upgradeRolling(dingocli, dcs, options)
+	}
+
+	// 3.2) OR upgrade service at once
 	if options.force {
 		return upgradeAtOnce(dingocli, dcs, options)
 	}
 
-	// 3.2) OR upgrade service one by one
+	// 3.3) OR upgrade service one by one
 	return upgradeOneByOne(dingocli, dcs, options)
 }
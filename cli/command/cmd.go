@@ -25,10 +25,12 @@ import (
 	"github.com/dingodb/dingocli/cli/command/component"
 	"github.com/dingodb/dingocli/cli/command/config"
 	"github.com/dingodb/dingocli/cli/command/fs"
+	"github.com/dingodb/dingocli/cli/command/history"
 	"github.com/dingodb/dingocli/cli/command/hosts"
 	"github.com/dingodb/dingocli/cli/command/mds"
 	"github.com/dingodb/dingocli/cli/command/monitor"
 	"github.com/dingodb/dingocli/cli/command/nfs"
+	configure "github.com/dingodb/dingocli/internal/configure/dingocli"
 	"github.com/dingodb/dingocli/internal/errno"
 	tools "github.com/dingodb/dingocli/internal/tools/upgrade"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
@@ -48,8 +50,14 @@ var dingoExample = `Examples:
   $ dingo -u                               # Upgrade dingo itself to the latest version`
 
 type rootOptions struct {
-	debug   bool
-	upgrade bool
+	debug           bool
+	upgrade         bool
+	dryRun          bool
+	dryRunFormat    string
+	concurrency     uint
+	hostConcurrency uint
+	roleConcurrency map[string]int
+	stepConcurrency map[string]int
 }
 
 func addSubCommands(cmd *cobra.Command, dingocli *cli.DingoCli) {
@@ -78,6 +86,7 @@ func addSubCommands(cmd *cobra.Command, dingocli *cli.DingoCli) {
 		mds.NewMDSCommand(dingocli),             // dingocli mds ...
 		fs.NewFSCommand(dingocli),               // dingocli fs ...
 		component.NewComponentCommand(dingocli), // dingocli component ...
+		history.NewHistoryCommand(dingocli),     // dingocli history ...
 
 		NewAuditCommand(dingocli),      // dingocli audit
 		NewCompletionCommand(dingocli), // dingocli completion
@@ -101,6 +110,37 @@ http://www.apache.org/licenses/LICENSE-2.0
 	cliutil.SetErr(cmd, dingocli)
 }
 
+// loadConcurrency loads playbook concurrency from dingo.yaml, the flags take precedence
+func loadConcurrency(cmd *cobra.Command, options rootOptions) (*configure.ConcurrencyConfig, error) {
+	concurrency, err := configure.ParseConcurrencyConfig(cliutil.GetConfigFile(cmd))
+	if err != nil {
+		return nil, err
+	}
+
+	if options.concurrency > 0 {
+		concurrency.Default = options.concurrency
+	}
+	if options.hostConcurrency > 0 {
+		concurrency.Host = options.hostConcurrency
+	}
+	for _, item := range []struct {
+		limits map[string]uint
+		flags  map[string]int
+	}{
+		{concurrency.Role, options.roleConcurrency},
+		{concurrency.Step, options.stepConcurrency},
+	} {
+		for key, n := range item.flags {
+			if n < 0 {
+				return nil, errno.ERR_INVALID_CONCURRENCY.F("%s=%d", key, n)
+			}
+			item.limits[configure.StepConcurrencyKey(key)] = uint(n)
+		}
+	}
+
+	return concurrency, nil
+}
+
 func NewDingoCliCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options rootOptions
 
@@ -122,12 +162,31 @@ func NewDingoCliCommand(dingocli *cli.DingoCli) *cobra.Command {
 			return fmt.Errorf("dingo: '%s' is not a dingo command.\n"+
 				"See 'dingo --help'", args[0])
 		},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// dingo.yaml is parsed only if the command runs playbook
+			dingocli.SetConcurrencyLoader(func() (*configure.ConcurrencyConfig, error) {
+				return loadConcurrency(cmd, options)
+			})
+			if !options.dryRun {
+				return nil
+			} else if !cliutil.IsDryRunSupported(cmd) {
+				// side effects of the command are not recorded
+				return errno.ERR_DRY_RUN_NOT_SUPPORTED.F("command: %s", cmd.CommandPath())
+			}
+			return dingocli.SetDryRun(options.dryRunFormat)
+		},
 		SilenceUsage:          true, // silence usage when an error occurs
 		DisableFlagsInUseLine: true,
 	}
 
 	cmd.Flags().BoolP("version", "v", false, "Print version information and quit")
 	cmd.PersistentFlags().BoolP("help", "h", false, "Print usage")
+	cmd.PersistentFlags().BoolVar(&options.dryRun, "dry-run", false, "Print the commands which playbook will execute instead of executing them")
+	cmd.PersistentFlags().StringVar(&options.dryRunFormat, "dry-run-format", "text", "Specify the dry run output format (text/json)")
+	cmd.PersistentFlags().UintVar(&options.concurrency, "concurrency", 0, "Specify the tasks executed in parallel for each playbook step")
+	cmd.PersistentFlags().UintVar(&options.hostConcurrency, "host-concurrency", 0, "Specify the tasks executed in parallel on one host")
+	cmd.PersistentFlags().StringToIntVar(&options.roleConcurrency, "role-concurrency", nil, "Specify the tasks executed in parallel for service role, e.g. store=1")
+	cmd.PersistentFlags().StringToIntVar(&options.stepConcurrency, "step-concurrency", nil, "Specify the tasks executed in parallel for playbook step, e.g. pull_image=5")
 	cmd.Flags().BoolVarP(&options.debug, "debug", "d", false, "Print debug information")
 	cmd.Flags().BoolVarP(&options.upgrade, "upgrade", "u", false, "Upgrade dingo itself to the latest version")
 
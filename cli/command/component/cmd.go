@@ -36,6 +36,13 @@ func NewComponentCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewUninstallCommand(dingocli),
 		NewUseCommand(dingocli),
 		NewUpdateCommand(dingocli),
+		NewVerifyCommand(dingocli),
+		NewMirrorCommand(dingocli),
+		NewLockCommand(dingocli),
+		NewHistoryCommand(dingocli),
+		NewRollbackCommand(dingocli),
+		NewPruneCommand(dingocli),
+		NewPushCommand(dingocli),
 	)
 
 	return cmd
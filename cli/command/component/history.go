@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPONENT_HISTORY_EXAMPLE = `Examples:
+   # show the activation history of dingo-client
+   $ dingo component history dingo-client`
+)
+
+type historyOptions struct {
+	component string
+}
+
+func NewHistoryCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options historyOptions
+
+	cmd := &cobra.Command{
+		Use:     "history <component> [OPTIONS]",
+		Short:   "show activation history of component",
+		Args:    utils.ExactArgs(1),
+		Example: COMPONENT_HISTORY_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.component = args[0]
+
+			return runHistory(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	return cmd
+}
+
+func runHistory(cmd *cobra.Command, dingocli *cli.DingoCli, options historyOptions) error {
+	componentManager, err := component.NewLocalComponentManager()
+	if err != nil {
+		return err
+	}
+
+	history := componentManager.History(options.component)
+	if len(history) == 0 {
+		fmt.Printf("No activation history of %s.\n", options.component)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Time\tVersion\tRelease\tActive")
+	fmt.Fprintln(w, "----\t-------\t-------\t------")
+	for _, activation := range history {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", activation.Time, activation.Version, activation.Release,
+			utils.Ternary(activation.Active, "*", ""))
+	}
+
+	return w.Flush()
+}
@@ -18,6 +18,7 @@ package component
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/component"
@@ -39,23 +40,35 @@ const (
    $ dingo component install dingo-client:main
 
    # install multiple components at once
-   $ dingo component install dingo-client:main dingo-cache dingo-mds:v3.0.5`
+   $ dingo component install dingo-client:main dingo-cache dingo-mds:v3.0.5
+
+   # install the latest version matches the constraint
+   $ dingo component install dingo-client@~3.0 "dingo-mds@>=3.0.5 <4"
+
+   # install all components locked for current cluster
+   $ dingo component install --locked`
 )
 
 type installOptions struct {
 	components []string
+	locked     bool
+	ignoreLock bool
+	lockFile   string
 }
 
 func NewInstallCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options installOptions
 
 	cmd := &cobra.Command{
-		Use:     "install <component1>[:version] [component2...N] [OPTIONS]",
+		Use:     "install <component1>[:version|@constraint] [component2...N] [OPTIONS]",
 		Short:   "install component(s)",
-		Args:    utils.RequiresMinArgs(1),
+		Args:    utils.RequiresMinArgs(0),
 		Example: COMPONENT_INSTALL_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			options.components = args
+			if !options.locked && len(options.components) == 0 {
+				return fmt.Errorf("no component specified")
+			}
 
 			return runInstall(cmd, dingocli, &options)
 		},
@@ -65,6 +78,10 @@ func NewInstallCommand(dingocli *cli.DingoCli) *cobra.Command {
 
 	utils.SetFlagErrorFunc(cmd)
 
+	cmd.Flags().BoolVar(&options.locked, "locked", false, "Install all components locked for current cluster")
+	cmd.Flags().BoolVar(&options.ignoreLock, "ignore-lock", false, "Ignore the versions locked for current cluster")
+	cmd.Flags().StringVar(&options.lockFile, "lock-file", "", "Use the lock file instead of the one of current cluster")
+
 	return cmd
 }
 
@@ -74,11 +91,34 @@ func runInstall(cmd *cobra.Command, dingocli *cli.DingoCli, options *installOpti
 		return err
 	}
 
+	var lock *component.ComponentsLock
+	if !options.ignoreLock {
+		if lock, _, err = loadClusterLock(dingocli, options.lockFile); err != nil {
+			return err
+		}
+		componentManager.UseLock(lock)
+	}
+	if options.locked {
+		if lock == nil || len(lock.Components) == 0 {
+			return fmt.Errorf("no component locked for current cluster")
+		}
+		for name := range lock.Components {
+			options.components = append(options.components, name)
+		}
+		sort.Strings(options.components)
+	}
+
 	var installed []string
 	var errors []error
 
 	for _, comp := range options.components {
 		name, version := component.ParseComponentVersion(comp)
+		version, err := lock.Resolve(name, version)
+		if err != nil {
+			errors = append(errors, err)
+			fmt.Println(err.Error())
+			continue
+		}
 		if comp, err := componentManager.InstallComponent(name, utils.Ternary(version == "", component.LASTEST_VERSION, version)); err != nil {
 			errors = append(errors, err)
 			fmt.Println(err.Error())
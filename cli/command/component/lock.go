@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPONENT_LOCK_EXAMPLE = `Examples:
+   # show the components locked for current cluster
+   $ dingo component lock
+
+   # lock the latest 1.2.x of dingo-client and latest 1.x of dingo-mds
+   $ dingo component lock dingo-client@~1.2 dingo-mds@^1.0
+
+   # lock with a version range
+   $ dingo component lock "dingo-cache@>=1.3 <2"`
+)
+
+type lockOptions struct {
+	components []string
+	file       string
+}
+
+func NewLockCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options lockOptions
+
+	cmd := &cobra.Command{
+		Use:     "lock [component@constraint...] [OPTIONS]",
+		Short:   "pin the resolved versions of components for current cluster",
+		Args:    utils.RequiresMinArgs(0),
+		Example: COMPONENT_LOCK_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.components = args
+
+			return runLock(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	cmd.Flags().StringVar(&options.file, "lock-file", "", "Use the lock file instead of the one of current cluster")
+
+	return cmd
+}
+
+// the lock file of current cluster, nil if no cluster specified
+func loadClusterLock(dingocli *cli.DingoCli, file string) (*component.ComponentsLock, string, error) {
+	cluster := dingocli.ClusterName()
+	if len(file) == 0 {
+		if len(cluster) == 0 {
+			return nil, "", nil
+		}
+		file = component.LockFilePath(cluster)
+	}
+
+	lock, err := component.LoadLock(file, cluster)
+	if err != nil {
+		return nil, "", err
+	}
+	return lock, file, nil
+}
+
+func runLock(cmd *cobra.Command, dingocli *cli.DingoCli, options lockOptions) error {
+	lock, file, err := loadClusterLock(dingocli, options.file)
+	if err != nil {
+		return err
+	} else if lock == nil {
+		return errno.ERR_NO_CLUSTER_SPECIFIED
+	}
+
+	if len(options.components) > 0 {
+		componentManager, err := component.NewComponentManager()
+		if err != nil {
+			return err
+		}
+
+		for _, comp := range options.components {
+			name, version := component.ParseComponentVersion(comp)
+			if _, err := componentManager.LockComponent(lock, name, version); err != nil {
+				return err
+			}
+		}
+		if err := lock.Save(file); err != nil {
+			return err
+		}
+		fmt.Printf("Successfully lock components into %s ^_^!\n", file)
+	}
+
+	if len(lock.Components) == 0 {
+		fmt.Println("No locked components.")
+		return nil
+	}
+
+	names := []string{}
+	for name := range lock.Components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Name\tVersion\tConstraint\tCommit\tSha256")
+	fmt.Fprintln(w, "----\t-------\t----------\t------\t------")
+	for _, name := range names {
+		locked := lock.Components[name]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", name, locked.Version, locked.Constraint, locked.Commit, locked.Sha256)
+	}
+	return w.Flush()
+}
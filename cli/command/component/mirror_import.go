@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPONENT_MIRROR_IMPORT_EXAMPLE = `Examples:
+   # import the bundle and use it as the mirror
+   $ dingo component mirror import bundle.tar
+
+   # use a local http mirror served by "dingo component mirror serve"
+   $ dingo component mirror import --url http://10.0.0.1:8080`
+)
+
+type mirrorImportOptions struct {
+	bundle string
+	url    string
+}
+
+func NewMirrorImportCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options mirrorImportOptions
+
+	cmd := &cobra.Command{
+		Use:     "import [BUNDLE] [OPTIONS]",
+		Short:   "import a bundle as local mirror, or use a local http mirror",
+		Args:    utils.RequiresMaxArgs(1),
+		Example: COMPONENT_MIRROR_IMPORT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.bundle = args[0]
+			}
+			if len(options.bundle) == 0 && len(options.url) == 0 {
+				return fmt.Errorf("no bundle or mirror url specified")
+			}
+
+			return runMirrorImport(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	cmd.Flags().StringVar(&options.url, "url", "", "Use the mirror url instead of importing a bundle, e.g. http://10.0.0.1:8080")
+
+	return cmd
+}
+
+func runMirrorImport(cmd *cobra.Command, dingocli *cli.DingoCli, options mirrorImportOptions) error {
+	mirror := options.url
+	if len(options.bundle) > 0 {
+		mirrorDir := filepath.Join(component.RepostoryDir, component.MIRROR_DIR)
+		names, err := component.ImportBundle(options.bundle, mirrorDir)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Import %v into %s\n", names, mirrorDir)
+		mirror = "file://" + mirrorDir
+	}
+
+	// check the mirror is loadable before use it
+	for _, name := range component.ALL_COMPONENTS {
+		if _, err := component.NewBinaryRepoData(mirror, name); err != nil {
+			return fmt.Errorf("invalid mirror %s: %w", mirror, err)
+		}
+	}
+	if err := component.SetMirrorURL(component.RepostoryDir, mirror); err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully use %s as component mirror ^_^!\n", mirror)
+	return nil
+}
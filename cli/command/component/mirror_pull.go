@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPONENT_MIRROR_PULL_EXAMPLE = `Examples:
+   # pack latest stable version of all components
+   $ dingo component mirror pull -o bundle.tar
+
+   # pack specified components and versions
+   $ dingo component mirror pull --components dingo-client,dingo-mds --versions v3.0.5,main -o bundle.tar`
+)
+
+type mirrorPullOptions struct {
+	components []string
+	versions   []string
+	output     string
+}
+
+func NewMirrorPullCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options mirrorPullOptions
+
+	cmd := &cobra.Command{
+		Use:     "pull [OPTIONS]",
+		Short:   "pack component binaries and metadata into a bundle",
+		Args:    utils.NoArgs,
+		Example: COMPONENT_MIRROR_PULL_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMirrorPull(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	cmd.Flags().StringSliceVar(&options.components, "components", []string{}, "Components to pack (default all)")
+	cmd.Flags().StringSliceVar(&options.versions, "versions", []string{}, "Versions to pack, e.g. v3.0.5, main or latest (default latest)")
+	cmd.Flags().StringVarP(&options.output, "output", "o", "bundle.tar", "Output bundle file")
+
+	return cmd
+}
+
+func runMirrorPull(cmd *cobra.Command, dingocli *cli.DingoCli, options mirrorPullOptions) error {
+	for _, name := range options.components {
+		if !utils.Slice2Map(component.ALL_COMPONENTS)[name] {
+			return fmt.Errorf("unknown component %s", name)
+		}
+	}
+
+	componentManager, err := component.NewComponentManager()
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "dingo-mirror-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	pulled, err := componentManager.PullComponents(dir, options.components, options.versions)
+	if err != nil {
+		return err
+	}
+	if err := component.PackBundle(dir, options.output); err != nil {
+		return fmt.Errorf("failed to pack bundle: %w", err)
+	}
+
+	fmt.Printf("Successfully pack %d component(s) into %s ^_^!\n", len(pulled), options.output)
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPONENT_MIRROR_SERVE_EXAMPLE = `Examples:
+   # serve the imported mirror
+   $ dingo component mirror serve --listen :8080
+
+   # serve the mirror directory
+   $ dingo component mirror serve /data/mirror --listen :8080`
+)
+
+type mirrorServeOptions struct {
+	dir    string
+	listen string
+}
+
+func NewMirrorServeCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options mirrorServeOptions
+
+	cmd := &cobra.Command{
+		Use:     "serve [DIR] [OPTIONS]",
+		Short:   "serve the mirror directory over http",
+		Args:    utils.RequiresMaxArgs(1),
+		Example: COMPONENT_MIRROR_SERVE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.dir = filepath.Join(component.RepostoryDir, component.MIRROR_DIR)
+			if len(args) > 0 {
+				options.dir = args[0]
+			}
+
+			return runMirrorServe(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	cmd.Flags().StringVar(&options.listen, "listen", ":8080", "Listen address of the http mirror")
+
+	return cmd
+}
+
+func runMirrorServe(cmd *cobra.Command, dingocli *cli.DingoCli, options mirrorServeOptions) error {
+	if _, err := os.Stat(options.dir); err != nil {
+		return fmt.Errorf("mirror directory %s not found, import a bundle first", options.dir)
+	}
+
+	fmt.Printf("Serve component mirror %s on %s, use it on other hosts by:\n", options.dir, options.listen)
+	fmt.Printf("  $ dingo component mirror import --url http://<this host>%s\n", options.listen)
+	return http.ListenAndServe(options.listen, http.FileServer(http.Dir(options.dir)))
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPONENT_PRUNE_EXAMPLE = `Examples:
+   # keep the active and the latest used build of each component
+   $ dingo component prune --keep 2
+
+   # only prune the builds of dingo-client
+   $ dingo component prune dingo-client --keep 3`
+)
+
+type pruneOptions struct {
+	component string
+	keep      int
+}
+
+func NewPruneCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options pruneOptions
+
+	cmd := &cobra.Command{
+		Use:     "prune [component] [OPTIONS]",
+		Short:   "remove old builds of component(s) to free disk space",
+		Args:    utils.RequiresMaxArgs(1),
+		Example: COMPONENT_PRUNE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.component = args[0]
+			}
+
+			return runPrune(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	flags := cmd.Flags()
+	flags.IntVar(&options.keep, "keep", 2, "Number of builds to keep for each component, including the active one")
+
+	return cmd
+}
+
+func runPrune(cmd *cobra.Command, dingocli *cli.DingoCli, options pruneOptions) error {
+	componentManager, err := component.NewLocalComponentManager()
+	if err != nil {
+		return err
+	}
+
+	removed, err := componentManager.Prune(options.component, options.keep)
+	if err != nil {
+		return err
+	}
+
+	if len(removed) == 0 {
+		fmt.Println("Nothing to prune.")
+		return nil
+	}
+
+	if err := componentManager.SaveInstalledComponents(); err != nil {
+		return err
+	}
+
+	for _, comp := range removed {
+		fmt.Printf("Removed %s:%s (%s)\n", comp.Name, comp.Version, comp.Release)
+	}
+	fmt.Printf("Successfully pruned %d build(s)\n", len(removed))
+
+	return nil
+}
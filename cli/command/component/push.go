@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPONENT_PUSH_EXAMPLE = `Examples:
+   # push the active dingo-client to the specified hosts
+   $ dingo component push dingo-client --hosts host1,host2
+
+   # push dingo-client v3.0.5 to all hosts which match the label, 20 hosts at a time
+   $ dingo component push dingo-client:v3.0.5 --labels gpu --parallel 20`
+)
+
+type pushOptions struct {
+	component string
+	hosts     []string
+	labels    []string
+	// not named --concurrency, which is the global flag limiting the
+	// tasks of playbook step, while push copies files without playbook
+	parallel uint
+}
+
+type pushResult struct {
+	host string
+	path string
+	err  error
+}
+
+func NewPushCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options pushOptions
+
+	cmd := &cobra.Command{
+		Use:     "push <component>[:version] [OPTIONS]",
+		Short:   "push installed component to remote hosts",
+		Args:    utils.ExactArgs(1),
+		Example: COMPONENT_PUSH_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.component = args[0]
+
+			return runPush(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	flags := cmd.Flags()
+	flags.StringSliceVar(&options.hosts, "hosts", []string{}, "Push to the specified hosts")
+	flags.StringSliceVarP(&options.labels, "labels", "l", []string{}, "Push to hosts which match the labels")
+	flags.UintVar(&options.parallel, "parallel", 10, "Number of hosts to push at the same time")
+
+	return cmd
+}
+
+func getPushHosts(dingocli *cli.DingoCli, options pushOptions) ([]*hosts.HostConfig, error) {
+	if len(options.hosts) == 0 && len(options.labels) == 0 {
+		return nil, fmt.Errorf("please specify the hosts to push by --hosts or --labels")
+	}
+
+	if len(options.hosts) > 0 {
+		hcs := []*hosts.HostConfig{}
+		for _, name := range options.hosts {
+			hc, err := dingocli.GetHost(name)
+			if err != nil {
+				return nil, err
+			}
+			hcs = append(hcs, hc)
+		}
+		return hcs, nil
+	}
+
+	hcs, err := hosts.Filter(dingocli.Hosts(), options.labels)
+	if err != nil {
+		return nil, err
+	} else if len(hcs) == 0 {
+		return nil, fmt.Errorf("no host matches the labels: %s", strings.Join(options.labels, ","))
+	}
+	return hcs, nil
+}
+
+// upload binary into ~/.dingo/components of ssh user and set it as default in remote installed.json
+func pushToHost(dingocli *cli.DingoCli, hc *hosts.HostConfig, comp *component.Component, digest string) (string, error) {
+	sshClient, err := module.NewSSHClient(*hc.GetSSHConfig())
+	if err != nil {
+		return "", fmt.Errorf("ssh connect failed: %w", err)
+	}
+	defer sshClient.Client().Close()
+
+	m := module.NewModule(sshClient)
+	options := dingocli.ExecOptions()
+	options.ExecWithSudo = false
+
+	home, err := m.Shell().Command("echo $HOME").Execute(options)
+	if err != nil {
+		return "", fmt.Errorf("get home directory failed: %w", err)
+	}
+	rootDir := filepath.Join(strings.TrimSpace(home), ".dingo", "components")
+	installedFile := filepath.Join(rootDir, component.INSTALLED_FILE)
+
+	installed, err := m.Shell().Command(fmt.Sprintf("cat %s 2>/dev/null || true", installedFile)).Execute(options)
+	if err != nil {
+		return "", fmt.Errorf("read %s failed: %w", installedFile, err)
+	}
+	target, data, err := component.MergePushedComponent([]byte(installed), comp, rootDir)
+	if err != nil {
+		return "", err
+	}
+
+	// upload to a temporary file first, a running binary can not be overwritten
+	filename := filepath.Join(target.Path, target.Name)
+	if _, err := m.Shell().Mkdir(target.Path).AddOption("-p").Execute(options); err != nil {
+		return "", fmt.Errorf("create %s failed: %w", target.Path, err)
+	}
+	if err := m.File().Upload(filepath.Join(comp.Path, comp.Name), filename+".push"); err != nil {
+		return "", fmt.Errorf("upload %s failed: %w", filename, err)
+	}
+	out, err := m.Shell().Command(fmt.Sprintf("sha256sum %s", filename+".push")).Execute(options)
+	if err != nil {
+		return "", fmt.Errorf("sha256sum %s failed: %w", filename, err)
+	} else if fields := strings.Fields(out); len(fields) == 0 || !strings.EqualFold(fields[0], digest) {
+		m.Shell().Remove(filename + ".push").Execute(options)
+		return "", fmt.Errorf("%w: expected %s, got %s", component.ErrDigestMismatch, digest, strings.TrimSpace(out))
+	}
+	if _, err := m.Shell().Chmod("0755", filename+".push").Execute(options); err != nil {
+		return "", fmt.Errorf("chmod %s failed: %w", filename, err)
+	}
+	if _, err := m.Shell().Rename(filename+".push", filename).Execute(options); err != nil {
+		return "", fmt.Errorf("rename %s failed: %w", filename, err)
+	}
+
+	tmpfile, err := module.NewFileManager(nil).InstallTmpFile(string(data))
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmpfile)
+	if err := m.File().Upload(tmpfile, installedFile+".push"); err != nil {
+		return "", fmt.Errorf("upload %s failed: %w", installedFile, err)
+	}
+	if _, err := m.Shell().Rename(installedFile+".push", installedFile).Execute(options); err != nil {
+		return "", fmt.Errorf("rename %s failed: %w", installedFile, err)
+	}
+
+	return filename, nil
+}
+
+func runPush(cmd *cobra.Command, dingocli *cli.DingoCli, options pushOptions) error {
+	componentManager, err := component.NewLocalComponentManager()
+	if err != nil {
+		return err
+	}
+
+	name, version := component.ParseComponentVersion(options.component)
+	comp, err := componentManager.FindPushComponent(name, version)
+	if err != nil {
+		return err
+	}
+
+	hcs, err := getPushHosts(dingocli, options)
+	if err != nil {
+		return err
+	}
+
+	// the uploaded binary is compared with local one before it takes effect
+	digest, err := component.FileSha256(filepath.Join(comp.Path, comp.Name))
+	if err != nil {
+		return err
+	}
+
+	if options.parallel == 0 {
+		options.parallel = 1
+	}
+	fmt.Printf("Pushing %s:%s (%s) to %d host(s)...\n", comp.Name, comp.Version, comp.Release, len(hcs))
+
+	var wg sync.WaitGroup
+	results := make([]*pushResult, len(hcs))
+	workers := make(chan struct{}, options.parallel)
+	for i, hc := range hcs {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, hc *hosts.HostConfig) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			path, err := pushToHost(dingocli, hc, comp, digest)
+			results[i] = &pushResult{host: hc.GetHost(), path: path, err: err}
+		}(i, hc)
+	}
+	wg.Wait()
+
+	failed := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Host\tStatus\tDetail")
+	fmt.Fprintln(w, "----\t------\t------")
+	for _, result := range results {
+		if result.err != nil {
+			failed++
+			fmt.Fprintf(w, "%s\t%s\t%s\n", result.host, "failed", result.err)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", result.host, "ok", result.path)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("push %s:%s failed on %d of %d host(s)", comp.Name, comp.Version, failed, len(results))
+	}
+	return nil
+}
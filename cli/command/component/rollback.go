@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPONENT_ROLLBACK_EXAMPLE = `Examples:
+   # re-activate the build of dingo-client which was active before the current one
+   $ dingo component rollback dingo-client`
+)
+
+type rollbackOptions struct {
+	component string
+}
+
+func NewRollbackCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options rollbackOptions
+
+	cmd := &cobra.Command{
+		Use:     "rollback <component> [OPTIONS]",
+		Short:   "re-activate the previous build of component",
+		Args:    utils.ExactArgs(1),
+		Example: COMPONENT_ROLLBACK_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.component = args[0]
+
+			return runRollback(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	return cmd
+}
+
+func runRollback(cmd *cobra.Command, dingocli *cli.DingoCli, options rollbackOptions) error {
+	componentManager, err := component.NewLocalComponentManager()
+	if err != nil {
+		return err
+	}
+
+	current, previous, err := componentManager.Rollback(options.component)
+	if err != nil {
+		return err
+	}
+
+	if err := componentManager.SaveInstalledComponents(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully rollback %s from %s (%s) to %s (%s)\n",
+		options.component, current.Version, current.Release, previous.Version, previous.Release)
+
+	return nil
+}
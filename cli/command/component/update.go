@@ -43,6 +43,7 @@ const (
 type updateOptions struct {
 	components []string
 	all        bool
+	lockFile   string
 }
 
 func NewUpdateCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -69,6 +70,7 @@ func NewUpdateCommand(dingocli *cli.DingoCli) *cobra.Command {
 	utils.SetFlagErrorFunc(cmd)
 
 	cmd.Flags().BoolVar(&options.all, "all", false, "Update all installed component to latest build")
+	cmd.Flags().StringVar(&options.lockFile, "lock-file", "", "Use the lock file instead of the one of current cluster")
 
 	return cmd
 }
@@ -79,6 +81,12 @@ func runUpdate(cmd *cobra.Command, dingocli *cli.DingoCli, options *updateOption
 		return err
 	}
 
+	lock, _, err := loadClusterLock(dingocli, options.lockFile)
+	if err != nil {
+		return err
+	}
+	componentManager.UseLock(lock)
+
 	updateFunc := func(name, version string) error {
 		comp, err := componentManager.UpdateComponent(name, version)
 		if err != nil {
@@ -115,6 +123,13 @@ func runUpdate(cmd *cobra.Command, dingocli *cli.DingoCli, options *updateOption
 		for _, compinfo := range options.components {
 			name, version := component.ParseComponentVersion(compinfo)
 
+			version, err := lock.Resolve(name, version)
+			if err != nil {
+				errors = append(errors, err)
+				fmt.Println(err.Error())
+				continue
+			}
+
 			targetVersion := utils.Ternary(version == "", component.LASTEST_VERSION, version)
 			if err := updateFunc(name, targetVersion); err != nil {
 				errors = append(errors, err)
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPONENT_VERIFY_EXAMPLE = `Examples:
+   # verify all installed components
+   $ dingo component verify
+
+   # verify the installed versions of specified components
+   $ dingo component verify dingo-client dingo-mds`
+)
+
+type verifyOptions struct {
+	components []string
+}
+
+func NewVerifyCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options verifyOptions
+
+	cmd := &cobra.Command{
+		Use:     "verify [component...] [OPTIONS]",
+		Short:   "verify installed component(s) against the recorded sha256 digest",
+		Args:    utils.RequiresMinArgs(0),
+		Example: COMPONENT_VERIFY_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.components = args
+
+			return runVerify(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	return cmd
+}
+
+func runVerify(cmd *cobra.Command, dingocli *cli.DingoCli, options verifyOptions) error {
+	componentManager, err := component.NewLocalComponentManager()
+	if err != nil {
+		return err
+	}
+
+	results, err := componentManager.VerifyComponents(options.components...)
+	if err != nil {
+		return err
+	}
+
+	if len(results) == 0 {
+		fmt.Println("No installed components.")
+		return nil
+	}
+
+	failed := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Name\tVersion\tStatus\tSha256")
+	fmt.Fprintln(w, "----\t-------\t------\t------")
+	for _, result := range results {
+		if result.Status == component.VERIFY_STATUS_MISMATCH || result.Status == component.VERIFY_STATUS_MISSING {
+			failed++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", result.Name, result.Version, result.Status, result.Actual)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d component(s) failed verification", failed, len(results))
+	}
+	return nil
+}
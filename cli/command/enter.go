@@ -76,6 +76,9 @@ func runEnter(dingocli *cli.DingoCli, options enterOptions) error {
 	}
 
 	// 4) attch remote container
+	if dc.GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD {
+		return tools.AttachRemoteHost(dingocli, dc.GetHost(), true)
+	}
 	home := dc.GetProjectLayout().ServiceRootDir
 	return tools.AttachRemoteContainer(dingocli, dc.GetHost(), containerId, home)
 }
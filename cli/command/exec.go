@@ -84,5 +84,8 @@ func runExec(dingocli *cli.DingoCli, options execOptions) error {
 	}
 
 	// 4) exec cmd in remote container
+	if dc.GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD {
+		return tools.ExecCmdInRemoteHost(dingocli, dc.GetHost(), options.cmd)
+	}
 	return tools.ExecCmdInRemoteContainer(dingocli, dc.GetHost(), containerId, options.cmd)
 }
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectCacheUsage(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	os.MkdirAll(filepath.Join(root, "a"), 0755)
+	os.WriteFile(filepath.Join(root, "1.bin"), make([]byte, 100), 0644)
+	os.WriteFile(filepath.Join(root, "a", "2.bin"), make([]byte, 300), 0644)
+
+	// half of each file is cached
+	usage, err := collectCacheUsage(root, func(path string) (int64, error) {
+		info, _ := os.Stat(path)
+		return info.Size() / 2, nil
+	})
+	assert.NoError(err)
+	assert.Equal(int64(2), usage.Files)
+	assert.Equal(int64(400), usage.Size)
+	assert.Equal(int64(200), usage.Cached)
+	assert.Equal("50.00%", usage.percent())
+
+	_, err = collectCacheUsage(root, func(path string) (int64, error) {
+		return 0, fmt.Errorf("not supported")
+	})
+	assert.Error(err)
+
+	assert.Equal("0.00%", (&cacheUsage{}).percent())
+}
+
+func TestGroupInodes(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	os.MkdirAll(filepath.Join(root, "fs1", "dir"), 0755)
+	os.MkdirAll(filepath.Join(root, "fs2"), 0755)
+	os.WriteFile(filepath.Join(root, "fs1", "dir", "1.bin"), []byte("1"), 0644)
+	os.WriteFile(filepath.Join(root, "fs2", "2.bin"), []byte("2"), 0644)
+
+	mountpoints := []*mountinfo.MountInfo{
+		{MountPoint: filepath.Join(root, "fs1")},
+		{MountPoint: filepath.Join(root, "fs2")},
+	}
+	groups, inodes, err := groupInodes([]string{
+		filepath.Join(root, "fs1", "dir"),
+		filepath.Join(root, "fs1", "dir", "1.bin"),
+		filepath.Join(root, "fs2", "2.bin"),
+		filepath.Join(root, "fs2", "2.bin"), // duplicate
+	}, mountpoints)
+	assert.NoError(err)
+	assert.Equal([]string{filepath.Join(root, "fs1"), filepath.Join(root, "fs2")}, groups)
+	assert.Len(inodes[filepath.Join(root, "fs1")], 2)
+	assert.Len(inodes[filepath.Join(root, "fs2")], 1)
+
+	_, _, err = groupInodes([]string{filepath.Join(root, "other")}, mountpoints)
+	assert.Error(err)
+}
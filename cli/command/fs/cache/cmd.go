@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// client xattr ops, see also DINGOFS_WARMUP_OP_XATTR
+const (
+	DINGOFS_CACHE_EVICT_OP_XATTR = "dingofs.cache.evict.op"
+	DINGOFS_CACHE_USAGE_XATTR    = "dingofs.cache.usage"
+)
+
+func NewCacheCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage local cache of files",
+		Args:  cliutil.NoArgs,
+	}
+
+	cmd.AddCommand(
+		NewCacheEvictCommand(dingocli),
+		NewCacheUsageCommand(dingocli),
+	)
+
+	return cmd
+}
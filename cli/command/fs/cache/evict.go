@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	CACHE_EVICT_EXAMPLE = `Examples:
+   # drop the cached blocks of one file
+   $ dingo fs cache evict /mnt/dingofs/bigfile.bin
+
+   # drop the cached blocks of all files in directory dir1
+   $ dingo fs cache evict /mnt/dingofs/dir1
+
+   # drop the cached blocks of all files(directories) in evict.lst
+   $ dingo fs cache evict --filelist /mnt/evict.lst`
+)
+
+type evictOptions struct {
+	paths    []string
+	filelist string
+}
+
+func NewCacheEvictCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options evictOptions
+
+	cmd := &cobra.Command{
+		Use:     "evict [PATH...] [OPTIONS]",
+		Short:   "Tell client to drop cached blocks of files(directories) from local cache",
+		Args:    utils.RequiresMinArgs(0),
+		Example: CACHE_EVICT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.paths = args
+			if options.filelist == "" && len(args) == 0 {
+				return fmt.Errorf("no evict file is specified")
+			}
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runEvict(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().StringVar(&options.filelist, "filelist", "", "Full path of file, save the files(dir) to evict")
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+
+	return cmd
+}
+
+// group the inodes by dingofs mountpoint, the directory inode is expanded
+// by the client itself just like warmup
+func groupInodes(paths []string, mountpoints []*mountinfo.MountInfo) ([]string, map[string][]string, error) {
+	groups := []string{}
+	inodes := map[string][]string{}
+	visited := map[string]bool{}
+	for _, path := range paths {
+		path = filepath.Clean(utils.AbsPath(path))
+		mountpoint := utils.FindMountPoint(path, mountpoints)
+		if mountpoint == nil {
+			return nil, nil, fmt.Errorf("[%s] is not saved in dingofs", path)
+		}
+
+		inodeId, err := utils.GetFileInode(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("stat [%s] fail: %v", path, err)
+		}
+		key := fmt.Sprintf("%s:%d", mountpoint.MountPoint, inodeId)
+		if inodeId == 0 || visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		if _, ok := inodes[mountpoint.MountPoint]; !ok {
+			groups = append(groups, mountpoint.MountPoint)
+		}
+		inodes[mountpoint.MountPoint] = append(inodes[mountpoint.MountPoint], fmt.Sprintf("%d", inodeId))
+	}
+	return groups, inodes, nil
+}
+
+func runEvict(cmd *cobra.Command, dingocli *cli.DingoCli, options evictOptions) error {
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return err
+	} else if len(mountpoints) == 0 {
+		return fmt.Errorf("no dingofs mountpoint found")
+	}
+
+	paths := options.paths
+	if options.filelist != "" {
+		lines, err := utils.ReadFileList(options.filelist)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, lines...)
+	}
+
+	groups, inodes, err := groupInodes(paths, mountpoints)
+	if err != nil {
+		return err
+	}
+
+	for _, mountpoint := range groups {
+		value := strings.Join(inodes[mountpoint], ",")
+		logger.Infof("evict cache, mountpoint: %s, inodes: %s", mountpoint, value)
+		err := unix.Setxattr(mountpoint, DINGOFS_CACHE_EVICT_OP_XATTR, []byte(value), 0)
+		if err == unix.ENOTSUP || err == unix.EOPNOTSUPP {
+			return fmt.Errorf("dingofs client of [%s] does not support cache eviction", mountpoint)
+		} else if err != nil {
+			return fmt.Errorf("evict [%s] failed: %s: %v", mountpoint, DINGOFS_CACHE_EVICT_OP_XATTR, err)
+		}
+		fmt.Printf("%s: evicted cache of %d file(s)/directory(s)\n", mountpoint, len(inodes[mountpoint]))
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/xattr"
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	CACHE_USAGE_EXAMPLE = `Examples:
+   $ dingo fs cache usage /mnt/dingofs/bigfile.bin
+   $ dingo fs cache usage /mnt/dingofs/dir1 --humanize`
+)
+
+type usageOptions struct {
+	paths    []string
+	humanize bool
+	format   string
+}
+
+type cacheUsage struct {
+	Path   string `json:"path"`
+	Files  int64  `json:"files"`
+	Size   int64  `json:"size"`
+	Cached int64  `json:"cached"`
+}
+
+type cachedBytesFunc func(path string) (int64, error)
+
+func NewCacheUsageCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options usageOptions
+
+	cmd := &cobra.Command{
+		Use:     "usage PATH... [OPTIONS]",
+		Short:   "Show how many bytes of files(directories) are cached locally",
+		Args:    utils.RequiresMinArgs(1),
+		Example: CACHE_USAGE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.paths = args
+			options.humanize = utils.GetBoolFlag(cmd, utils.DINGOFS_HUMANIZE)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runUsage(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddBoolFlag(cmd, utils.DINGOFS_HUMANIZE, "Humanize display")
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+// the client reports cached bytes of a file in xattr, no data means nothing cached
+func getCachedBytes(path string) (int64, error) {
+	value, err := xattr.Get(path, DINGOFS_CACHE_USAGE_XATTR)
+	if err != nil {
+		if xerr, ok := err.(*xattr.Error); ok {
+			switch xerr.Err {
+			case unix.ENODATA:
+				return 0, nil
+			case unix.ENOTSUP:
+				return 0, fmt.Errorf("dingofs client does not support cache usage")
+			}
+		}
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(value)), 10, 64)
+}
+
+// walk the path and sum the size and cached bytes of the regular files
+func collectCacheUsage(root string, cachedBytes cachedBytesFunc) (*cacheUsage, error) {
+	usage := &cacheUsage{Path: root}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		cached, err := cachedBytes(path)
+		if err != nil {
+			return fmt.Errorf("get cache usage of [%s] failed: %v", path, err)
+		}
+		usage.Files++
+		usage.Size += info.Size()
+		usage.Cached += cached
+		return nil
+	})
+	return usage, err
+}
+
+func (u *cacheUsage) percent() string {
+	if u.Size == 0 {
+		return "0.00%"
+	}
+	return fmt.Sprintf("%.2f%%", float64(u.Cached)*100/float64(u.Size))
+}
+
+func runUsage(cmd *cobra.Command, dingocli *cli.DingoCli, options usageOptions) error {
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return err
+	}
+
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+	usages := []*cacheUsage{}
+	for _, path := range options.paths {
+		path = filepath.Clean(utils.AbsPath(path))
+		if utils.FindMountPoint(path, mountpoints) == nil {
+			return fmt.Errorf("[%s] is not saved in dingofs", path)
+		}
+		usage, err := collectCacheUsage(path, getCachedBytes)
+		if err != nil {
+			return err
+		}
+		usages = append(usages, usage)
+	}
+	outputResult.Result = usages
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_PATH, common.ROW_FILES, common.ROW_SIZE, common.ROW_CACHED, common.ROW_CACHED_PERCENT}
+	table.SetHeader(header)
+	for _, usage := range usages {
+		row := make(map[string]string)
+		row[common.ROW_PATH] = usage.Path
+		row[common.ROW_FILES] = fmt.Sprintf("%d", usage.Files)
+		if options.humanize {
+			row[common.ROW_SIZE] = humanize.IBytes(uint64(usage.Size))
+			row[common.ROW_CACHED] = humanize.IBytes(uint64(usage.Cached))
+		} else {
+			row[common.ROW_SIZE] = fmt.Sprintf("%d", usage.Size)
+			row[common.ROW_CACHED] = fmt.Sprintf("%d", usage.Cached)
+		}
+		row[common.ROW_CACHED_PERCENT] = usage.percent()
+		table.Append(table.Map2List(row, header))
+	}
+	table.RenderWithNoData("no file")
+
+	return nil
+}
@@ -18,6 +18,7 @@ package fs
 
 import (
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/cache"
 	"github.com/dingodb/dingocli/cli/command/fs/config"
 	"github.com/dingodb/dingocli/cli/command/fs/quota"
 	"github.com/dingodb/dingocli/cli/command/fs/subpath"
@@ -46,6 +47,7 @@ func NewFSCommand(dingocli *cli.DingoCli) *cobra.Command {
 		config.NewFsCommand(dingocli),
 		quota.NewQuotaCommand(dingocli),
 		warmup.NewWarmupCommand(dingocli),
+		cache.NewCacheCommand(dingocli),
 		subpath.NewSubpathCommand(dingocli),
 		NewStatsCommand(dingocli),
 	)
@@ -37,7 +37,16 @@ import (
 
 const (
 	STATS_MOUNTPOINT_EXAMPLE = `Examples:
-   $ dingo fs stats /mnt/dingofs`
+   $ dingo fs stats /mnt/dingofs
+
+   # show p50/p95/p99 latency of ops
+   $ dingo fs stats /mnt/dingofs --percentile
+
+   # highlight the breaches, exit non-zero in headless mode
+   $ dingo fs stats /mnt/dingofs --alert 'fuse.ops.p99>50ms' --alert 'usage.cpu>80%'
+
+   # run hook command on breaches in headless mode
+   $ dingo fs stats /mnt/dingofs --alert 'fuse.ops.p99>50ms' --alert-hook '/usr/local/bin/notify.sh' > stats.log`
 )
 
 // colors
@@ -59,6 +68,7 @@ const (
 	COLOR_SEQ      = "\033[1;"
 	COLOR_DARK_SEQ = "\033[0;"
 	UNDERLINE_SEQ  = "\033[4m"
+	REVERSE_SEQ    = "\033[7m"
 	CLEAR_SCREEM   = "\033[2J\033[1;1H"
 )
 
@@ -96,6 +106,10 @@ type statsWatcher struct {
 	sections   []*section
 	cpuUsage   float64
 	count      uint32
+	percentile bool
+	rules      []*alertRule
+	alertHook  string
+	alerting   bool // alert rules are checked since the first whole interval
 }
 
 type statsOptions struct {
@@ -104,6 +118,9 @@ type statsOptions struct {
 	interval   time.Duration
 	count      uint32
 	verbose    bool
+	percentile bool
+	alerts     []string
+	alertHook  string
 }
 
 // set logout to stdout
@@ -135,15 +152,16 @@ func NewStatsCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.Flags().StringVar(&options.schema, "schema", "ufbor", `Schema string that controls the output sections (u: usage, f: fuse, b: blockcache, o: object, r:remotecache) (default "ufbor")"`)
 	cmd.Flags().Uint32VarP(&options.count, "count", "c", 0, "Max outout count(0 is unlimited)")
 	cmd.Flags().BoolVarP(&options.verbose, "verbose", "v", false, "Show more info")
+	cmd.Flags().BoolVarP(&options.percentile, "percentile", "p", false, "Show p50/p95/p99 latency of ops (requires bucketed histograms in .stats)")
+	cmd.Flags().StringArrayVar(&options.alerts, "alert", []string{}, "Alert rule like 'fuse.ops.p99>50ms' (SECTION.ITEM[.FIELD]{>,>=,<,<=}VALUE[UNIT]), can be specified multiple times")
+	cmd.Flags().StringVar(&options.alertHook, "alert-hook", "", "Command to run on alert breaches in headless mode instead of exiting non-zero")
 
 	return cmd
 }
 
 func runStats(cmd *cobra.Command, dingocli *cli.DingoCli, options statsOptions) error {
 
-	realTimeStats(options)
-
-	return nil
+	return realTimeStats(options)
 }
 
 func (w *statsWatcher) colorize(msg string, color int, dark bool, underline bool) string {
@@ -186,11 +204,11 @@ func (w *statsWatcher) buildSchema(schema string, verbose bool) {
 			s.name = "object"
 			s.items = append(s.items, &item{"get", "dingofs_block_read_block_bps_total_count", metricByte | metricCounter})
 			if verbose {
-				s.items = append(s.items, &item{"ops", "dingofs_block_read_block", metricTime | metricHist})
+				s.items = append(s.items, &item{"getop", "dingofs_block_read_block", metricTime | metricHist})
 			}
 			s.items = append(s.items, &item{"put", "dingofs_block_write_block_bps_total_count", metricByte | metricCounter})
 			if verbose {
-				s.items = append(s.items, &item{"ops", "dingofs_block_write_block", metricTime | metricHist})
+				s.items = append(s.items, &item{"putop", "dingofs_block_write_block", metricTime | metricHist})
 			}
 		case 'r':
 			s.name = "remotecache"
@@ -238,6 +256,11 @@ func (w *statsWatcher) formatHeader() {
 			if it.typ&metricHist != 0 {
 				if it.typ&metricTime != 0 {
 					subs = append(subs, w.colorize(" lat ", BLUE, false, true))
+					if w.percentile {
+						for _, p := range percentiles {
+							subs = append(subs, w.colorize(padding(p.field, MaxItemSize, ' '), BLUE, false, true))
+						}
+					}
 				} else {
 					subs = append(subs, w.colorize(" avg ", BLUE, false, true))
 				}
@@ -362,24 +385,50 @@ func readStats(mp string) map[string]float64 {
 	return metricDataMap
 }
 
-func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
+func (w *statsWatcher) highlight(msg string) string {
+	if !w.colorful {
+		return msg
+	}
+	return fmt.Sprintf("%s%s%s", REVERSE_SEQ, msg, RESET_SEQ)
+}
+
+// printDiff prints one line and returns the breached alert rules,
+// rules are only checked for the whole interval (not dark) line
+func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) []*alertBreach {
 	if !w.colorful && dark {
-		return
+		return nil
 	}
+	breaches := []*alertBreach{}
 	values := make([]string, len(w.sections))
 	for i, s := range w.sections {
 		vals := make([]string, 0, len(s.items))
+		check := func(it *item, field string, value float64, formatted string) string {
+			if dark || !w.alerting {
+				return formatted
+			}
+			breached := false
+			for _, rule := range w.rules {
+				if rule.match(s.name, it.nick, field) && rule.breached(value) {
+					breaches = append(breaches, &alertBreach{rule: rule, value: value})
+					breached = true
+				}
+			}
+			if breached {
+				return w.highlight(formatted)
+			}
+			return formatted
+		}
 		for _, it := range s.items {
 			switch it.typ & 0xF0 {
 			case metricGauge: // show current value
-				vals = append(vals, w.formatU64(right[it.name], dark, true))
+				vals = append(vals, check(it, FIELD_VALUE, right[it.name], w.formatU64(right[it.name], dark, true)))
 			case metricCounter:
 				v := (right[it.name] - left[it.name])
 				if !dark {
 					v /= float64(w.interval)
 				}
 				if it.typ&metricByte != 0 {
-					vals = append(vals, w.formatU64(v, dark, true))
+					vals = append(vals, check(it, FIELD_VALUE, v, w.formatU64(v, dark, true)))
 				} else if it.typ&metricCPU != 0 {
 					v = right[it.name] //reset value to current for cpu
 					w.cpuUsage += v
@@ -388,11 +437,11 @@ func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
 						v /= float64(w.interval)
 						w.cpuUsage = 0.0
 					}
-					vals = append(vals, w.formatCPU(v, dark))
+					vals = append(vals, check(it, FIELD_VALUE, v*100.0, w.formatCPU(v, dark)))
 				} else if it.typ&metricTime != 0 {
-					vals = append(vals, w.formatTime(v, dark))
+					vals = append(vals, check(it, FIELD_VALUE, v, w.formatTime(v, dark)))
 				} else { // metricCount
-					vals = append(vals, w.formatU64(v, dark, false))
+					vals = append(vals, check(it, FIELD_VALUE, v, w.formatU64(v, dark, false)))
 				}
 			case metricHist: // metricTime
 				count := right[it.name+"_qps_total_count"] - left[it.name+"_qps_total_count"]
@@ -407,7 +456,19 @@ func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
 				if !dark {
 					count /= float64(w.interval)
 				}
-				vals = append(vals, w.formatU64(count, dark, false), w.formatTime(avg, dark))
+				vals = append(vals, check(it, FIELD_OPS, count, w.formatU64(count, dark, false)),
+					check(it, FIELD_LAT, avg, w.formatTime(avg, dark)))
+				if w.percentile && it.typ&metricTime != 0 {
+					for _, p := range percentiles {
+						v := histPercentile(left, right, it.name, p.value)
+						vals = append(vals, check(it, p.field, v, w.formatTime(v, dark)))
+					}
+				} else if len(w.rules) > 0 && it.typ&metricTime != 0 && !dark {
+					// percentile alerts work without showing the columns
+					for _, p := range percentiles {
+						check(it, p.field, histPercentile(left, right, it.name, p.value), "")
+					}
+				}
 
 			case metricHit: // metricHits
 				hitCount := right[it.name+"_hit_count"] - left[it.name+"_hit_count"]
@@ -417,7 +478,7 @@ func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
 				if totalCount > 0.0 {
 					avg = hitCount / totalCount
 				}
-				vals = append(vals, w.formatHits(avg, dark))
+				vals = append(vals, check(it, FIELD_VALUE, avg*100.0, w.formatHits(avg, dark)))
 			}
 		}
 		values[i] = strings.Join(vals, " ")
@@ -427,10 +488,37 @@ func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
 	} else {
 		fmt.Printf("%s\n", strings.Join(values, w.colorize("|", BLUE, true, false)))
 	}
+	return breaches
+}
+
+// in terminal the breaches are highlighted and printed, in headless mode
+// run the alert hook if specified, otherwise exit with error
+func (w *statsWatcher) handleBreaches(breaches []*alertBreach) error {
+	if len(breaches) == 0 {
+		return nil
+	}
+
+	for _, b := range breaches {
+		fmt.Fprintln(os.Stderr, w.colorize("ALERT: "+b.String(), RED, false, false))
+	}
+	if w.colorful {
+		return nil
+	} else if len(w.alertHook) > 0 {
+		if err := runAlertHook(w.alertHook, w.mountPoint, breaches); err != nil {
+			fmt.Fprintf(os.Stderr, "run alert hook failed: %v\n", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("%d alert rule(s) breached on %s", len(breaches), w.mountPoint)
 }
 
 // real time read metric data and show in client
-func realTimeStats(options statsOptions) {
+func realTimeStats(options statsOptions) error {
+	rules, err := parseAlertRules(options.alerts)
+	if err != nil {
+		return err
+	}
+
 	inode, err := utils.GetFileInode(options.mountpoint)
 	if err != nil {
 		log.Fatalf("run stats failed, %s", err)
@@ -445,8 +533,14 @@ func realTimeStats(options statsOptions) {
 		interval:   int64(options.interval) / 1000000000,
 		cpuUsage:   0.0,
 		count:      options.count,
+		percentile: options.percentile,
+		rules:      rules,
+		alertHook:  options.alertHook,
 	}
 	watcher.buildSchema(options.schema, options.verbose)
+	if err := watcher.validateRules(); err != nil {
+		return err
+	}
 	watcher.formatHeader()
 
 	var tick uint
@@ -461,8 +555,13 @@ func realTimeStats(options statsOptions) {
 			fmt.Println(watcher.header)
 		}
 		if tick%uint(watcher.interval) == 0 {
-			watcher.printDiff(start, current, false)
+			breaches := watcher.printDiff(start, current, false)
 			start = current
+			if err := watcher.handleBreaches(breaches); err != nil {
+				return err
+			}
+			// the first line compares the stats with itself, all counters are zero
+			watcher.alerting = true
 		} else {
 			watcher.printDiff(last, current, true)
 		}
@@ -476,4 +575,5 @@ func realTimeStats(options statsOptions) {
 		}
 	}
 
+	return nil
 }
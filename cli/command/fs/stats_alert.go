@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// alert fields of a metricHist item, the other items only have FIELD_VALUE
+const (
+	FIELD_VALUE = "value"
+	FIELD_OPS   = "ops"
+	FIELD_LAT   = "lat"
+	FIELD_P50   = "p50"
+	FIELD_P95   = "p95"
+	FIELD_P99   = "p99"
+)
+
+// bucketed histogram exposed in .stats, e.g.:
+//
+//	dingofs_fuse_op_all_lat_bucket_1000 : 25
+//	dingofs_fuse_op_all_lat_bucket_inf  : 30
+//
+// the suffix is the upper bound of bucket in microseconds and the
+// value is the cumulative count of all requests under the bound
+const (
+	BUCKET_SUFFIX   = "_lat_bucket_"
+	BUCKET_INFINITY = "inf"
+)
+
+var (
+	percentiles = []struct {
+		field string
+		value float64
+	}{
+		{FIELD_P50, 0.50},
+		{FIELD_P95, 0.95},
+		{FIELD_P99, 0.99},
+	}
+
+	// fuse.ops.p99>50ms, object.get>=100M, usage.cpu>80%
+	regexAlertRule = regexp.MustCompile(`^([a-z]+)\.([a-z]+)(?:\.([a-z0-9]+))?\s*(>=|<=|>|<)\s*([0-9.]+)\s*([a-zA-Z%]*)$`)
+)
+
+type alertRule struct {
+	expr      string
+	section   string
+	nick      string
+	field     string
+	op        string
+	threshold float64
+}
+
+type alertBreach struct {
+	rule  *alertRule
+	value float64
+}
+
+type bucket struct {
+	key   string
+	bound float64 // upper bound in microseconds
+	count float64 // cumulative count
+}
+
+func parseAlertRule(expr string) (*alertRule, error) {
+	mu := regexAlertRule.FindStringSubmatch(strings.TrimSpace(expr))
+	if len(mu) == 0 {
+		return nil, fmt.Errorf("invalid alert rule '%s', should be like 'fuse.ops.p99>50ms'", expr)
+	}
+
+	threshold, err := strconv.ParseFloat(mu[5], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert threshold '%s': %v", mu[5], err)
+	}
+	scale, err := unitScale(mu[6])
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert rule '%s': %v", expr, err)
+	}
+
+	field := mu[3]
+	if len(field) == 0 {
+		field = FIELD_VALUE
+	}
+	return &alertRule{
+		expr:      expr,
+		section:   mu[1],
+		nick:      mu[2],
+		field:     field,
+		op:        mu[4],
+		threshold: threshold * scale,
+	}, nil
+}
+
+func parseAlertRules(exprs []string) ([]*alertRule, error) {
+	rules := []*alertRule{}
+	for _, expr := range exprs {
+		rule, err := parseAlertRule(expr)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// alertFields returns the fields which can be alerted on of the item
+func (it *item) alertFields() []string {
+	if it.typ&0xF0 != metricHist {
+		return []string{FIELD_VALUE}
+	}
+	fields := []string{FIELD_OPS, FIELD_LAT}
+	if it.typ&metricTime != 0 {
+		for _, p := range percentiles {
+			fields = append(fields, p.field)
+		}
+	}
+	return fields
+}
+
+func (s *section) nicks() []string {
+	nicks := []string{}
+	for _, it := range s.items {
+		nicks = append(nicks, it.nick)
+	}
+	return nicks
+}
+
+// validateRule checks the section, item and field of rule against the
+// schema, so a typo doesn't make the rule never breached
+func (w *statsWatcher) validateRule(rule *alertRule) error {
+	names := []string{}
+	for _, s := range w.sections {
+		names = append(names, s.name)
+		if s.name != rule.section {
+			continue
+		}
+
+		for _, it := range s.items {
+			if it.nick != rule.nick {
+				continue
+			}
+			fields := it.alertFields()
+			for _, field := range fields {
+				if field == rule.field {
+					return nil
+				}
+			}
+			return fmt.Errorf("invalid alert rule '%s': unknown field '%s' of %s.%s, should be one of [%s]",
+				rule.expr, rule.field, rule.section, rule.nick, strings.Join(fields, ", "))
+		}
+		return fmt.Errorf("invalid alert rule '%s': unknown item '%s' of %s, should be one of [%s]",
+			rule.expr, rule.nick, rule.section, strings.Join(s.nicks(), ", "))
+	}
+	return fmt.Errorf("invalid alert rule '%s': unknown section '%s', should be one of [%s] in schema",
+		rule.expr, rule.section, strings.Join(names, ", "))
+}
+
+func (w *statsWatcher) validateRules() error {
+	for _, rule := range w.rules {
+		if err := w.validateRule(rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// times are compared in milliseconds, percentages in [0, 100] and bytes in byte
+func unitScale(unit string) (float64, error) {
+	switch strings.ToLower(unit) {
+	case "", "%", "ms":
+		return 1, nil
+	case "us":
+		return 1.0 / 1000, nil
+	case "s":
+		return 1000, nil
+	case "b":
+		return 1, nil
+	case "k", "kb", "kib":
+		return 1 << 10, nil
+	case "m", "mb", "mib":
+		return 1 << 20, nil
+	case "g", "gb", "gib":
+		return 1 << 30, nil
+	case "t", "tb", "tib":
+		return 1 << 40, nil
+	}
+	return 0, fmt.Errorf("unknown unit '%s'", unit)
+}
+
+func (r *alertRule) match(section, nick, field string) bool {
+	return r.section == section && r.nick == nick && r.field == field
+}
+
+func (r *alertRule) breached(value float64) bool {
+	switch r.op {
+	case ">":
+		return value > r.threshold
+	case ">=":
+		return value >= r.threshold
+	case "<":
+		return value < r.threshold
+	case "<=":
+		return value <= r.threshold
+	}
+	return false
+}
+
+func (b *alertBreach) String() string {
+	return fmt.Sprintf("%s (current: %.2f)", b.rule.expr, b.value)
+}
+
+// run hook command with breach information passed by environment variables
+func runAlertHook(hook, mountpoint string, breaches []*alertBreach) error {
+	rules := []string{}
+	values := []string{}
+	for _, b := range breaches {
+		rules = append(rules, b.rule.expr)
+		values = append(values, fmt.Sprintf("%.2f", b.value))
+	}
+
+	cmd := exec.Command("bash", "-c", hook)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(),
+		"DINGOFS_STATS_MOUNTPOINT="+mountpoint,
+		"DINGOFS_STATS_ALERT_RULES="+strings.Join(rules, ";"),
+		"DINGOFS_STATS_ALERT_VALUES="+strings.Join(values, ";"))
+	return cmd.Run()
+}
+
+func getBuckets(stats map[string]float64, name string) []bucket {
+	prefix := name + BUCKET_SUFFIX
+	buckets := []bucket{}
+	for key, value := range stats {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		suffix := strings.TrimPrefix(key, prefix)
+		if suffix == BUCKET_INFINITY {
+			buckets = append(buckets, bucket{key, math.Inf(1), value})
+		} else if bound, err := strconv.ParseFloat(suffix, 64); err == nil {
+			buckets = append(buckets, bucket{key, bound, value})
+		}
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].bound < buckets[j].bound
+	})
+	return buckets
+}
+
+// calculate latency percentile (milliseconds) of requests between left and right
+func histPercentile(left, right map[string]float64, name string, q float64) float64 {
+	buckets := getBuckets(right, name)
+	if len(buckets) == 0 {
+		return 0
+	}
+	for i := range buckets {
+		buckets[i].count -= left[buckets[i].key]
+	}
+
+	total := buckets[len(buckets)-1].count
+	if total <= 0 {
+		return 0
+	}
+
+	target := total * q
+	var lower, prev float64
+	for _, b := range buckets {
+		if b.count >= target {
+			if math.IsInf(b.bound, 1) {
+				return lower / 1000
+			} else if b.count == prev {
+				return b.bound / 1000
+			}
+			return (lower + (b.bound-lower)*(target-prev)/(b.count-prev)) / 1000
+		}
+		lower, prev = b.bound, b.count
+	}
+	return lower / 1000
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAlertRule(t *testing.T) {
+	rule, err := parseAlertRule("fuse.ops.p99>50ms")
+	assert.NoError(t, err)
+	assert.Equal(t, "fuse", rule.section)
+	assert.Equal(t, "ops", rule.nick)
+	assert.Equal(t, FIELD_P99, rule.field)
+	assert.Equal(t, ">", rule.op)
+	assert.Equal(t, 50.0, rule.threshold)
+	assert.True(t, rule.breached(50.1))
+	assert.False(t, rule.breached(50))
+
+	rule, err = parseAlertRule("object.get >= 100M")
+	assert.NoError(t, err)
+	assert.Equal(t, FIELD_VALUE, rule.field)
+	assert.Equal(t, float64(100<<20), rule.threshold)
+	assert.True(t, rule.breached(100<<20))
+
+	rule, err = parseAlertRule("fuse.ops.lat<1s")
+	assert.NoError(t, err)
+	assert.Equal(t, 1000.0, rule.threshold)
+
+	_, err = parseAlertRule("fuse.ops.p99=50ms")
+	assert.Error(t, err)
+	_, err = parseAlertRule("fuse.ops.p99>50parsecs")
+	assert.Error(t, err)
+}
+
+func TestValidateAlertRules(t *testing.T) {
+	watcher := &statsWatcher{}
+	watcher.buildSchema("fo", false)
+
+	for _, expr := range []string{"fuse.ops.p99>50ms", "fuse.ops.lat>10ms", "fuse.read<1M", "object.put.value>1G"} {
+		rule, err := parseAlertRule(expr)
+		assert.NoError(t, err)
+		watcher.rules = []*alertRule{rule}
+		assert.NoError(t, watcher.validateRules(), expr)
+	}
+
+	for expr, message := range map[string]string{
+		"fuse.opz.p99>50ms":   "unknown item 'opz'",
+		"fuse.read.p99>50ms":  "unknown field 'p99'",
+		"fuse.ops>100":        "unknown field 'value'",
+		"usage.cpu>80%":       "unknown section 'usage'",
+		"object.getop.p99>1s": "unknown item 'getop'", // only shown with --verbose
+	} {
+		rule, err := parseAlertRule(expr)
+		assert.NoError(t, err)
+		watcher.rules = []*alertRule{rule}
+		err = watcher.validateRules()
+		assert.Error(t, err, expr)
+		assert.Contains(t, err.Error(), message)
+	}
+}
+
+func TestHistPercentile(t *testing.T) {
+	name := "dingofs_fuse_op_all"
+	left := map[string]float64{
+		name + "_lat_bucket_1000":  10,
+		name + "_lat_bucket_10000": 10,
+		name + "_lat_bucket_inf":   10,
+	}
+	right := map[string]float64{
+		name + "_lat_bucket_1000":  60,  // 50 requests <= 1ms
+		name + "_lat_bucket_10000": 105, // 45 requests <= 10ms
+		name + "_lat_bucket_inf":   110, // 5 requests > 10ms
+	}
+
+	assert.InDelta(t, 1.0, histPercentile(left, right, name, 0.50), 0.001)
+	assert.InDelta(t, 10.0, histPercentile(left, right, name, 0.95), 0.001)
+	assert.InDelta(t, 10.0, histPercentile(left, right, name, 0.99), 0.001)
+	assert.InDelta(t, 5.5, histPercentile(left, right, name, 0.725), 0.001)
+
+	// no bucket or no request
+	assert.Equal(t, 0.0, histPercentile(left, left, name, 0.99))
+	assert.Equal(t, 0.0, histPercentile(nil, map[string]float64{}, name, 0.99))
+}
+
+func TestAlertFromFirstInterval(t *testing.T) {
+	rule, err := parseAlertRule("fuse.read<1M")
+	assert.NoError(t, err)
+	watcher := &statsWatcher{interval: 1, rules: []*alertRule{rule}}
+	watcher.buildSchema("fo", true)
+
+	// the first line compares the stats with itself
+	stats := map[string]float64{"dingofs_vfs_read_bps_total_count": 100}
+	assert.Empty(t, watcher.printDiff(stats, stats, false))
+
+	watcher.alerting = true
+	assert.Len(t, watcher.printDiff(stats, stats, false), 1)
+
+	// object ops of get and put are alerted separately
+	names := map[string]bool{}
+	for _, s := range watcher.sections {
+		for _, it := range s.items {
+			assert.False(t, names[s.name+"."+it.nick], "duplicate item %s.%s", s.name, it.nick)
+			names[s.name+"."+it.nick] = true
+		}
+	}
+}
@@ -20,12 +20,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/output"
 	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
 	"golang.org/x/sys/unix"
 
 	"github.com/spf13/cobra"
@@ -40,26 +42,45 @@ const (
    $ dingo fs warmup add /mnt/bigfile.bin
 
    # warmup all files in directory dir1
-   $ dingo fs warmup add /mnt/dir1`
+   $ dingo fs warmup add /mnt/dir1
+
+   # warmup parquet files modified in last 7 days under several mountpoints, at most 200GiB
+   $ dingo fs warmup add /mnt/fs1/dataset /mnt/fs2/dataset --include '*.parquet' --newer-than 7d --max-bytes 200GiB
+
+   # only report the file count and bytes which will be warmed up
+   $ dingo fs warmup add /mnt/dir1 --exclude '*.tmp' --dry-run
+
+   # block until warmup finished, stream progress in JSON lines and exit non-zero on timeout or errors
+   $ dingo fs warmup add /mnt/dir1 --wait --timeout 30m`
 )
 
 type addOptions struct {
-	filepath string
-	daemon   bool
-	single   bool
-	filelist string
+	filepath  string
+	daemon    bool
+	single    bool
+	filelist  string
+	paths     []string
+	includes  []string
+	excludes  []string
+	newerThan string
+	maxBytes  string
+	dryRun    bool
+	wait      bool
+	timeout   time.Duration
+	interval  time.Duration
 }
 
 func NewWarmupAddCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options addOptions
 
 	cmd := &cobra.Command{
-		Use:     "add [OPTIONS]",
+		Use:     "add [PATH...] [OPTIONS]",
 		Short:   "Tell client to warmup files(directories) to local cache",
-		Args:    utils.RequiresMaxArgs(1),
+		Args:    utils.RequiresMinArgs(0),
 		Example: WARMUP_ADD_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
+			options.paths = args
 			if options.filelist == "" && len(args) == 0 {
 				return fmt.Errorf("no warmup file is specified")
 			} else if options.filelist != "" {
@@ -71,6 +92,12 @@ func NewWarmupAddCommand(dingocli *cli.DingoCli) *cobra.Command {
 				options.single = true
 			}
 
+			if options.wait && options.daemon {
+				return fmt.Errorf("--wait and --daemon can't be specified at the same time")
+			}
+
+			// the global --dry-run only reports the file count and bytes
+			options.dryRun = dingocli.DryRun()
 			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
 
 			return runAdd(cmd, dingocli, options)
@@ -84,11 +111,30 @@ func NewWarmupAddCommand(dingocli *cli.DingoCli) *cobra.Command {
 	// add flags
 	cmd.Flags().StringVar(&options.filelist, "filelist", "", `Full path of file, save the files(dir) to warmup, and should be in dingofs"`)
 	cmd.Flags().BoolVarP(&options.daemon, "daemon", "d", false, "Run in background")
+	cmd.Flags().StringSliceVar(&options.includes, "include", []string{}, "Only warmup files which match the glob patterns, e.g. '*.parquet'")
+	cmd.Flags().StringSliceVar(&options.excludes, "exclude", []string{}, "Skip files which match the glob patterns, e.g. '*.tmp'")
+	cmd.Flags().StringVar(&options.newerThan, "newer-than", "", "Only warmup files modified within the duration (e.g. 12h, 7d) or after the date (e.g. 2026-01-02)")
+	cmd.Flags().StringVar(&options.maxBytes, "max-bytes", "", "Bytes budget of warmup, most recently modified files are picked first (e.g. 100GiB)")
+	cmd.Flags().BoolVar(&options.wait, "wait", false, "Wait until warmup finished and print progress events in JSON lines")
+	cmd.Flags().DurationVar(&options.timeout, "timeout", 0, "Timeout for --wait (0 is unlimited)")
+	cmd.Flags().DurationVar(&options.interval, "interval", 1*time.Second, "Interval to poll progress for --wait")
+	utils.SupportDryRun(cmd)
 
 	return cmd
 }
 
+// the selection is built by dingo itself instead of the client
+// when filtering or multiple paths are required
+func (o *addOptions) needExpand() bool {
+	return len(o.includes) > 0 || len(o.excludes) > 0 ||
+		len(o.newerThan) > 0 || len(o.maxBytes) > 0 ||
+		o.dryRun || len(o.paths) > 1
+}
+
 func runAdd(cmd *cobra.Command, dingocli *cli.DingoCli, options addOptions) error {
+	if options.needExpand() {
+		return runAddSelection(cmd, dingocli, options)
+	}
 
 	// check has dingofs mountpoint
 	mountpoints, err := utils.GetDingoFSMountPoints()
@@ -142,13 +188,13 @@ func runAdd(cmd *cobra.Command, dingocli *cli.DingoCli, options addOptions) erro
 		inodesStr = inodes
 	}
 
-	err = unix.Setxattr(options.filepath, DINGOFS_WARMUP_OP_XATTR, []byte(inodesStr), 0)
-	if err == unix.ENOTSUP || err == unix.EOPNOTSUPP {
-		return fmt.Errorf("filesystem does not support extended attributes")
-	} else if err != nil {
-		return fmt.Errorf("%s: %v", DINGOFS_WARMUP_OP_XATTR, err)
+	err = setWarmupXattr(options.filepath, inodesStr)
+	if err != nil {
+		return err
 	}
-	if !options.daemon {
+	if options.wait {
+		return newWarmupWaiter(os.Stdout, options.timeout, options.interval).wait([]string{options.filepath})
+	} else if !options.daemon {
 		time.Sleep(1 * time.Second) //wait for 1s
 		options := queryOptions{
 			path: options.filepath,
@@ -160,3 +206,146 @@ func runAdd(cmd *cobra.Command, dingocli *cli.DingoCli, options addOptions) erro
 
 	return nil
 }
+
+func setWarmupXattr(path string, inodesStr string) error {
+	err := unix.Setxattr(path, DINGOFS_WARMUP_OP_XATTR, []byte(inodesStr), 0)
+	if err == unix.ENOTSUP || err == unix.EOPNOTSUPP {
+		return fmt.Errorf("filesystem does not support extended attributes")
+	} else if err != nil {
+		return fmt.Errorf("%s: %v", DINGOFS_WARMUP_OP_XATTR, err)
+	}
+	return nil
+}
+
+// parse duration like 30m, 12h, 7d or date like 2006-01-02, 2006-01-02 15:04:05
+func parseNewerThan(value string, now time.Time) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil || days < 0 {
+			return time.Time{}, fmt.Errorf("invalid --newer-than: %s", value)
+		}
+		return now.AddDate(0, 0, -days), nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return time.Time{}, fmt.Errorf("invalid --newer-than: %s", value)
+	}
+	return now.Add(-duration), nil
+}
+
+func buildWarmupFilter(options addOptions) (*utils.WarmupFilter, error) {
+	filter := &utils.WarmupFilter{
+		Includes: options.includes,
+		Excludes: options.excludes,
+	}
+	if err := utils.ValidateGlobs(append(options.includes, options.excludes...)); err != nil {
+		return nil, err
+	}
+	if len(options.newerThan) > 0 {
+		newerThan, err := parseNewerThan(options.newerThan, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		filter.NewerThan = newerThan
+	}
+	if len(options.maxBytes) > 0 {
+		maxBytes, err := humanize.ParseBytes(options.maxBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --max-bytes: %s", options.maxBytes)
+		}
+		filter.MaxBytes = maxBytes
+	}
+	return filter, nil
+}
+
+// expand the paths recursively, filter the files and tell the client of
+// each mountpoint to warmup the selected inodes
+func runAddSelection(cmd *cobra.Command, dingocli *cli.DingoCli, options addOptions) error {
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return err
+	} else if len(mountpoints) == 0 {
+		return fmt.Errorf("no dingofs mountpoint found")
+	}
+
+	filter, err := buildWarmupFilter(options)
+	if err != nil {
+		return err
+	}
+
+	paths := options.paths
+	if options.filelist != "" {
+		lines, err := utils.ReadFileList(options.filelist)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, lines...)
+	}
+	for _, path := range paths {
+		if _, err := os.Stat(path); err != nil {
+			return fmt.Errorf("stat [%s] fail: %v", path, err)
+		}
+	}
+
+	files, err := utils.CollectWarmupFiles(paths, mountpoints, filter)
+	if err != nil {
+		return err
+	} else if len(files) == 0 {
+		return fmt.Errorf("no file matched for warmup")
+	}
+
+	// group inodes by mountpoint, each client only warmup its own inodes
+	groups := []string{}
+	inodes := map[string][]string{}
+	bytes := map[string]uint64{}
+	var totalBytes uint64
+	for _, file := range files {
+		if _, ok := inodes[file.MountPoint]; !ok {
+			groups = append(groups, file.MountPoint)
+		}
+		inodes[file.MountPoint] = append(inodes[file.MountPoint], fmt.Sprintf("%d", file.Inode))
+		bytes[file.MountPoint] += uint64(file.Size)
+		totalBytes += uint64(file.Size)
+	}
+
+	// keep stdout for progress events while waiting
+	out := os.Stdout
+	if options.wait {
+		out = os.Stderr
+	}
+	for _, mountpoint := range groups {
+		fmt.Fprintf(out, "%s: %d files, %s\n", mountpoint, len(inodes[mountpoint]), humanize.IBytes(bytes[mountpoint]))
+	}
+	fmt.Fprintf(out, "Total: %d files, %s\n", len(files), humanize.IBytes(totalBytes))
+	if options.dryRun {
+		return nil
+	}
+
+	for _, mountpoint := range groups {
+		err := setWarmupXattr(mountpoint, strings.Join(inodes[mountpoint], ","))
+		if err != nil {
+			return fmt.Errorf("warmup [%s] failed: %v", mountpoint, err)
+		}
+	}
+
+	if options.wait {
+		return newWarmupWaiter(os.Stdout, options.timeout, options.interval).wait(groups)
+	} else if options.daemon {
+		fmt.Println("Successfully run warmup in background, you can run following commands to query progress:")
+		for _, mountpoint := range groups {
+			fmt.Printf("  dingo fs warmup query %s\n", mountpoint)
+		}
+		return nil
+	}
+	time.Sleep(1 * time.Second) //wait for 1s
+	for _, mountpoint := range groups {
+		runQuery(cmd, dingocli, queryOptions{path: mountpoint})
+	}
+	return nil
+}
@@ -36,6 +36,7 @@ func NewWarmupCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.AddCommand(
 		NewWarmupAddCommand(dingocli),
 		NewWarmupQueryCommand(dingocli),
+		NewWarmupProfileCommand(dingocli),
 	)
 
 	return cmd
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	REGEX_WARMUP_PROFILE_NAME = `^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`
+)
+
+// warmupProfile is a named set of paths and filters, it is saved as json
+// in the warmup_profiles table
+type warmupProfile struct {
+	Paths     []string `json:"paths"`
+	Includes  []string `json:"includes,omitempty"`
+	Excludes  []string `json:"excludes,omitempty"`
+	NewerThan string   `json:"newer_than,omitempty"`
+	MaxBytes  string   `json:"max_bytes,omitempty"`
+	Hosts     []string `json:"hosts,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Schedule  string   `json:"schedule,omitempty"`
+}
+
+func NewWarmupProfileCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage saved warmup profiles",
+		Args:  cliutil.NoArgs,
+	}
+
+	cmd.AddCommand(
+		NewProfileCreateCommand(dingocli),
+		NewProfileListCommand(dingocli),
+		NewProfileDeleteCommand(dingocli),
+		NewProfileRunCommand(dingocli),
+	)
+
+	return cmd
+}
+
+func checkProfileName(name string) error {
+	if !regexp.MustCompile(REGEX_WARMUP_PROFILE_NAME).MatchString(name) {
+		return fmt.Errorf("invalid profile name '%s', only letters, digits, '_', '.' and '-' are allowed", name)
+	}
+	return nil
+}
+
+func getWarmupProfile(dingocli *cli.DingoCli, name string) (*warmupProfile, error) {
+	profiles, err := dingocli.Storage().GetWarmupProfile(name)
+	if err != nil {
+		return nil, errno.ERR_GET_WARMUP_PROFILE_FAILED.E(err)
+	} else if len(profiles) == 0 {
+		return nil, errno.ERR_WARMUP_PROFILE_NOT_FOUND.F("profile: %s", name)
+	}
+	return decodeWarmupProfile(profiles[0].Data)
+}
+
+func decodeWarmupProfile(data string) (*warmupProfile, error) {
+	profile := &warmupProfile{}
+	if err := json.Unmarshal([]byte(data), profile); err != nil {
+		return nil, errno.ERR_DECODE_WARMUP_PROFILE_FAILED.E(err)
+	}
+	return profile, nil
+}
+
+func (p *warmupProfile) encode() (string, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", errno.ERR_ENCODE_INFO_TO_JSON_FAILED.E(err)
+	}
+	return string(data), nil
+}
+
+func (p *warmupProfile) addOptions() addOptions {
+	return addOptions{
+		paths:     p.Paths,
+		includes:  p.Includes,
+		excludes:  p.Excludes,
+		newerThan: p.NewerThan,
+		maxBytes:  p.MaxBytes,
+	}
+}
+
+// validate the profile with the same rules as "warmup add"
+func (p *warmupProfile) validate() error {
+	if len(p.Paths) == 0 {
+		return fmt.Errorf("no warmup path is specified")
+	}
+	for _, path := range p.Paths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("warmup path '%s' must be an absolute path", path)
+		}
+	}
+	_, err := buildWarmupFilter(p.addOptions())
+	return err
+}
+
+func (p *warmupProfile) filterString() string {
+	items := []string{}
+	if len(p.Includes) > 0 {
+		items = append(items, "include="+strings.Join(p.Includes, ","))
+	}
+	if len(p.Excludes) > 0 {
+		items = append(items, "exclude="+strings.Join(p.Excludes, ","))
+	}
+	if len(p.NewerThan) > 0 {
+		items = append(items, "newer-than="+p.NewerThan)
+	}
+	if len(p.MaxBytes) > 0 {
+		items = append(items, "max-bytes="+p.MaxBytes)
+	}
+	return strings.Join(items, "\n")
+}
+
+func (p *warmupProfile) targetString() string {
+	if len(p.Hosts) > 0 {
+		return "hosts=" + strings.Join(p.Hosts, ",")
+	} else if len(p.Labels) > 0 {
+		return "labels=" + strings.Join(p.Labels, ",")
+	}
+	return "local"
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// build the "warmup add" command which runs the profile on remote host
+func (p *warmupProfile) addCommand(binary string, timeout string) string {
+	args := []string{binary, "fs", "warmup", "add"}
+	for _, path := range p.Paths {
+		args = append(args, shellQuote(path))
+	}
+	for _, pattern := range p.Includes {
+		args = append(args, "--include", shellQuote(pattern))
+	}
+	for _, pattern := range p.Excludes {
+		args = append(args, "--exclude", shellQuote(pattern))
+	}
+	if len(p.NewerThan) > 0 {
+		args = append(args, "--newer-than", shellQuote(p.NewerThan))
+	}
+	if len(p.MaxBytes) > 0 {
+		args = append(args, "--max-bytes", shellQuote(p.MaxBytes))
+	}
+	args = append(args, "--wait", "--timeout", timeout)
+	return strings.Join(args, " ")
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	WARMUP_PROFILE_CREATE_EXAMPLE = `Examples:
+   # save a profile which warmup parquet files modified in last 1 day
+   $ dingo fs warmup profile create daily-dataset /mnt/dingofs/dataset --include '*.parquet' --newer-than 1d
+
+   # run the profile on all hosts labeled with "inference" at 06:00 every day
+   $ dingo fs warmup profile create daily-dataset /mnt/dingofs/dataset --labels inference --schedule '0 6 * * *' --force`
+)
+
+type profileCreateOptions struct {
+	name      string
+	paths     []string
+	includes  []string
+	excludes  []string
+	newerThan string
+	maxBytes  string
+	hosts     []string
+	labels    []string
+	schedule  string
+	force     bool
+}
+
+func NewProfileCreateCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options profileCreateOptions
+
+	cmd := &cobra.Command{
+		Use:     "create NAME PATH... [OPTIONS]",
+		Short:   "Create a warmup profile",
+		Args:    utils.RequiresMinArgs(2),
+		Example: WARMUP_PROFILE_CREATE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.name = args[0]
+			options.paths = args[1:]
+			return runProfileCreate(dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	flags := cmd.Flags()
+	flags.StringSliceVar(&options.includes, "include", []string{}, "Only warmup files which match the glob patterns, e.g. '*.parquet'")
+	flags.StringSliceVar(&options.excludes, "exclude", []string{}, "Skip files which match the glob patterns, e.g. '*.tmp'")
+	flags.StringVar(&options.newerThan, "newer-than", "", "Only warmup files modified within the duration (e.g. 12h, 7d) or after the date (e.g. 2026-01-02)")
+	flags.StringVar(&options.maxBytes, "max-bytes", "", "Bytes budget of warmup on each host (e.g. 100GiB)")
+	flags.StringSliceVar(&options.hosts, "hosts", []string{}, "Run the profile on these hosts by default")
+	flags.StringSliceVarP(&options.labels, "labels", "l", []string{}, "Run the profile on hosts which match the labels by default")
+	flags.StringVar(&options.schedule, "schedule", "", "Run the profile periodically from crontab of current user (e.g. '0 6 * * *', @daily)")
+	flags.BoolVarP(&options.force, "force", "f", false, "Overwrite the profile if it already exists")
+
+	return cmd
+}
+
+func runProfileCreate(dingocli *cli.DingoCli, options profileCreateOptions) error {
+	name := options.name
+	if err := checkProfileName(name); err != nil {
+		return err
+	}
+
+	paths := []string{}
+	for _, path := range options.paths {
+		paths = append(paths, filepath.Clean(path))
+	}
+	profile := &warmupProfile{
+		Paths:     paths,
+		Includes:  options.includes,
+		Excludes:  options.excludes,
+		NewerThan: options.newerThan,
+		MaxBytes:  options.maxBytes,
+		Hosts:     options.hosts,
+		Labels:    options.labels,
+		Schedule:  options.schedule,
+	}
+	if err := profile.validate(); err != nil {
+		return err
+	} else if len(profile.Schedule) > 0 {
+		if err := utils.CheckCronSchedule(profile.Schedule); err != nil {
+			return err
+		}
+	}
+	if len(profile.Hosts) > 0 || len(profile.Labels) > 0 {
+		if _, err := selectHosts(dingocli, profile.Hosts, profile.Labels); err != nil {
+			return err
+		}
+	}
+
+	data, err := profile.encode()
+	if err != nil {
+		return err
+	}
+
+	storage := dingocli.Storage()
+	profiles, err := storage.GetWarmupProfile(name)
+	if err != nil {
+		return errno.ERR_GET_WARMUP_PROFILE_FAILED.E(err)
+	} else if len(profiles) > 0 && !options.force {
+		return errno.ERR_WARMUP_PROFILE_ALREADY_EXIST.
+			F("profile: %s, use --force to overwrite it", name)
+	}
+
+	scheduled := false
+	if len(profiles) > 0 {
+		old, err := decodeWarmupProfile(profiles[0].Data)
+		scheduled = err != nil || len(old.Schedule) > 0
+		err = storage.SetWarmupProfile(name, data)
+		if err != nil {
+			return errno.ERR_UPDATE_WARMUP_PROFILE_FAILED.E(err)
+		}
+	} else {
+		err = storage.InsertWarmupProfile(name, data)
+		if err != nil {
+			return errno.ERR_INSERT_WARMUP_PROFILE_FAILED.E(err)
+		}
+	}
+
+	// the old schedule is removed if overwritten without --schedule
+	if scheduled || len(profile.Schedule) > 0 {
+		if err := setWarmupSchedule(dingocli, name, profile.Schedule); err != nil {
+			return err
+		}
+	}
+
+	dingocli.WriteOutln("Warmup profile '%s' saved", name)
+	if len(profile.Schedule) > 0 {
+		dingocli.WriteOutln("Scheduled at '%s', see crontab -l", profile.Schedule)
+	}
+	return nil
+}
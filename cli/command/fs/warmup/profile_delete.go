@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewProfileDeleteCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete NAME...",
+		Aliases: []string{"rm"},
+		Short:   "Delete warmup profiles and their schedules",
+		Args:    utils.RequiresMinArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProfileDelete(dingocli, args)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	return cmd
+}
+
+func runProfileDelete(dingocli *cli.DingoCli, names []string) error {
+	for _, name := range names {
+		profile, err := getWarmupProfile(dingocli, name)
+		if err != nil {
+			return err
+		}
+
+		if len(profile.Schedule) > 0 {
+			if err := setWarmupSchedule(dingocli, name, ""); err != nil {
+				return err
+			}
+		}
+		if err := dingocli.Storage().DeleteWarmupProfile(name); err != nil {
+			return errno.ERR_DELETE_WARMUP_PROFILE_FAILED.E(err)
+		}
+		dingocli.WriteOutln("Warmup profile '%s' deleted", name)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewProfileListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List warmup profiles",
+		Args:    utils.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProfileList(dingocli)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	return cmd
+}
+
+func runProfileList(dingocli *cli.DingoCli) error {
+	profiles, err := dingocli.Storage().GetWarmupProfiles()
+	if err != nil {
+		return errno.ERR_GET_WARMUP_PROFILE_FAILED.E(err)
+	}
+
+	header := []string{common.ROW_NAME, common.ROW_PATH, common.ROW_FILTER, common.ROW_TARGET, common.ROW_SCHEDULE, common.ROW_CREATE_TIME}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, item := range profiles {
+		profile, err := decodeWarmupProfile(item.Data)
+		if err != nil {
+			return err
+		}
+
+		row := make(map[string]string)
+		row[common.ROW_NAME] = item.Name
+		row[common.ROW_PATH] = strings.Join(profile.Paths, "\n")
+		row[common.ROW_FILTER] = utils.Choose(len(profile.filterString()) > 0, profile.filterString(), common.ROW_VALUE_NO_VALUE)
+		row[common.ROW_TARGET] = profile.targetString()
+		row[common.ROW_SCHEDULE] = utils.Choose(len(profile.Schedule) > 0, profile.Schedule, common.ROW_VALUE_NO_VALUE)
+		row[common.ROW_CREATE_TIME] = item.CreateTime.Format("2006-01-02 15:04:05")
+		rows = append(rows, row)
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_NAME})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no warmup profile")
+
+	return nil
+}
@@ -0,0 +1,255 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+	"github.com/spf13/cobra"
+)
+
+const (
+	WARMUP_PROFILE_RUN_EXAMPLE = `Examples:
+   # run the profile on the hosts saved in profile, or local host if none
+   $ dingo fs warmup profile run daily-dataset
+
+   # run the profile on specified hosts and give up after 1 hour
+   $ dingo fs warmup profile run daily-dataset --hosts host1,host2 --timeout 1h`
+)
+
+type profileRunOptions struct {
+	name     string
+	hosts    []string
+	labels   []string
+	binary   string
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// result of running the profile on one host
+type hostWarmupResult struct {
+	host     string
+	files    int64
+	finished int64
+	errors   int64
+	elapsed  float64
+	message  string
+	err      error
+}
+
+func NewProfileRunCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options profileRunOptions
+
+	cmd := &cobra.Command{
+		Use:     "run NAME [OPTIONS]",
+		Short:   "Run a warmup profile on local host or remote hosts",
+		Args:    utils.ExactArgs(1),
+		Example: WARMUP_PROFILE_RUN_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.name = args[0]
+			return runProfileRun(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	flags := cmd.Flags()
+	flags.StringSliceVar(&options.hosts, "hosts", []string{}, "Run the profile on these hosts instead of the saved ones")
+	flags.StringSliceVarP(&options.labels, "labels", "l", []string{}, "Run the profile on hosts which match the labels instead of the saved ones")
+	flags.StringVar(&options.binary, "dingo-path", "dingo", "Path of dingo on remote hosts")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Timeout of warmup on each host (0 is unlimited)")
+	flags.DurationVar(&options.interval, "interval", 1*time.Second, "Interval to poll progress when run on local host")
+
+	return cmd
+}
+
+func selectHosts(dingocli *cli.DingoCli, names, labels []string) ([]*hosts.HostConfig, error) {
+	if len(names) > 0 {
+		hcs := []*hosts.HostConfig{}
+		for _, name := range names {
+			hc, err := dingocli.GetHost(name)
+			if err != nil {
+				return nil, err
+			}
+			hcs = append(hcs, hc)
+		}
+		return hcs, nil
+	}
+
+	data := dingocli.Hosts()
+	if len(data) == 0 {
+		return nil, errno.ERR_HOST_NOT_FOUND.F("labels: %s", strings.Join(labels, ","))
+	}
+	hcs, err := hosts.Filter(data, labels)
+	if err != nil {
+		return nil, err
+	} else if len(hcs) == 0 {
+		return nil, errno.ERR_HOST_NOT_FOUND.F("labels: %s", strings.Join(labels, ","))
+	}
+	return hcs, nil
+}
+
+func runProfileRun(cmd *cobra.Command, dingocli *cli.DingoCli, options profileRunOptions) error {
+	profile, err := getWarmupProfile(dingocli, options.name)
+	if err != nil {
+		return err
+	}
+
+	names, labels := profile.Hosts, profile.Labels
+	if len(options.hosts) > 0 || len(options.labels) > 0 {
+		names, labels = options.hosts, options.labels
+	}
+
+	// no hosts specified, warmup on current host like "warmup add --wait"
+	if len(names) == 0 && len(labels) == 0 {
+		addOptions := profile.addOptions()
+		addOptions.wait = true
+		addOptions.timeout = options.timeout
+		addOptions.interval = options.interval
+		return runAddSelection(cmd, dingocli, addOptions)
+	}
+
+	hcs, err := selectHosts(dingocli, names, labels)
+	if err != nil {
+		return err
+	}
+
+	command := profile.addCommand(options.binary, options.timeout.String())
+	execOptions := dingocli.ExecOptions()
+	execOptions.ExecTimeoutSec = 0
+	if options.timeout > 0 {
+		// leave some time for collecting the files
+		execOptions.ExecTimeoutSec = int(options.timeout.Seconds()) + 60
+	}
+
+	dingocli.WriteOutln("Run warmup profile '%s' on %d host(s)...", options.name, len(hcs))
+	var wg sync.WaitGroup
+	results := make([]*hostWarmupResult, len(hcs))
+	for i, hc := range hcs {
+		wg.Add(1)
+		go func(i int, hc *hosts.HostConfig) {
+			defer wg.Done()
+			out, err := runOnHost(hc, command, execOptions)
+			results[i] = parseWarmupOutput(hc.GetHost(), out, err)
+		}(i, hc)
+	}
+	wg.Wait()
+
+	failed := outputHostResults(results)
+	if failed > 0 {
+		return errno.ERR_RUN_WARMUP_PROFILE_FAILED.
+			F("%d of %d host(s) failed", failed, len(results))
+	}
+	return nil
+}
+
+func runOnHost(hc *hosts.HostConfig, command string, options module.ExecOptions) (string, error) {
+	sshClient, err := module.NewSSHClient(*hc.GetSSHConfig())
+	if err != nil {
+		return "", errno.ERR_SSH_CONNECT_FAILED.E(err)
+	}
+	defer sshClient.Client().Close()
+
+	return module.NewModule(sshClient).Shell().Command(command).Execute(options)
+}
+
+// summarize the json progress events printed by "warmup add --wait"
+func parseWarmupOutput(host, out string, err error) *hostWarmupResult {
+	result := &hostWarmupResult{host: host, err: err}
+	events := map[string]*warmupEvent{}
+	paths := []string{}
+	lines := []string{}
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		event := &warmupEvent{}
+		if !strings.HasPrefix(line, "{") || json.Unmarshal([]byte(line), event) != nil {
+			lines = append(lines, line)
+			continue
+		}
+		if _, ok := events[event.Path]; !ok {
+			paths = append(paths, event.Path)
+		}
+		events[event.Path] = event
+		if len(event.Message) > 0 {
+			result.message = event.Message
+		}
+	}
+
+	for _, path := range paths {
+		event := events[path]
+		result.files += event.Total
+		result.finished += event.Finished
+		result.errors += event.Errors
+		if event.Elapsed > result.elapsed {
+			result.elapsed = event.Elapsed
+		}
+	}
+
+	// no events, e.g. the command not found or nothing matched
+	if err != nil && len(result.message) == 0 {
+		result.message = err.Error()
+		if len(lines) > 0 {
+			result.message = lines[len(lines)-1]
+		}
+	}
+	return result
+}
+
+func outputHostResults(results []*hostWarmupResult) int {
+	failed := 0
+	header := []string{common.ROW_HOST, common.ROW_RESULT, common.ROW_FILES, common.ROW_FINISHED, common.ROW_ERRORS, common.ROW_ELAPSED, common.ROW_REASON}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, result := range results {
+		row := make(map[string]string)
+		row[common.ROW_HOST] = result.host
+		row[common.ROW_RESULT] = common.ROW_VALUE_SUCCESS
+		if result.err != nil {
+			row[common.ROW_RESULT] = common.ROW_VALUE_FAILED
+			failed++
+		}
+		row[common.ROW_FILES] = fmt.Sprintf("%d", result.files)
+		row[common.ROW_FINISHED] = fmt.Sprintf("%d", result.finished)
+		row[common.ROW_ERRORS] = fmt.Sprintf("%d", result.errors)
+		row[common.ROW_ELAPSED] = time.Duration(result.elapsed * float64(time.Second)).Round(time.Second).String()
+		row[common.ROW_REASON] = utils.Choose(len(result.message) > 0, result.message, common.ROW_VALUE_NO_VALUE)
+		rows = append(rows, row)
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_HOST})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no host")
+	return failed
+}
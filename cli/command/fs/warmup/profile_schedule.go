@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+const (
+	WARMUP_SCHEDULE_TAG = "# dingo-warmup-profile:"
+)
+
+// install (or remove if schedule is empty) the crontab entry which runs
+// the profile periodically on current host
+func setWarmupSchedule(dingocli *cli.DingoCli, name, schedule string) error {
+	content, err := utils.ReadCrontab()
+	if err != nil {
+		return errno.ERR_UPDATE_WARMUP_SCHEDULE_FAILED.E(err)
+	}
+
+	line := ""
+	if len(schedule) > 0 {
+		binary, err := os.Executable()
+		if err != nil {
+			return errno.ERR_UPDATE_WARMUP_SCHEDULE_FAILED.E(err)
+		}
+		logfile := filepath.Join(dingocli.LogDir(), fmt.Sprintf("warmup-%s.log", name))
+		line = fmt.Sprintf("%s %s fs warmup profile run %s >> %s 2>&1",
+			schedule, binary, name, logfile)
+	}
+
+	// the entry is tagged with the profile name
+	updated := utils.UpdateCrontab(content, WARMUP_SCHEDULE_TAG+name, line)
+	if updated == content {
+		return nil
+	}
+	if err := utils.WriteCrontab(updated); err != nil {
+		return errno.ERR_UPDATE_WARMUP_SCHEDULE_FAILED.E(err)
+	}
+	return nil
+}
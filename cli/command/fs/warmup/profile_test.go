@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileAddCommand(t *testing.T) {
+	profile := &warmupProfile{
+		Paths:     []string{"/mnt/fs/data set"},
+		Includes:  []string{"*.parquet"},
+		NewerThan: "7d",
+		MaxBytes:  "100GiB",
+	}
+	assert.Equal(t, "dingo fs warmup add '/mnt/fs/data set' --include '*.parquet' "+
+		"--newer-than '7d' --max-bytes '100GiB' --wait --timeout 0s",
+		profile.addCommand("dingo", "0s"))
+	assert.Equal(t, `'it'\''s'`, shellQuote("it's"))
+
+	assert.Error(t, (&warmupProfile{}).validate())
+	assert.Error(t, (&warmupProfile{Paths: []string{"relative/dir"}}).validate())
+	assert.Error(t, (&warmupProfile{Paths: []string{"/mnt/fs"}, NewerThan: "yesterday"}).validate())
+	assert.NoError(t, profile.validate())
+}
+
+func TestParseWarmupOutput(t *testing.T) {
+	assert := assert.New(t)
+	out := `/mnt/fs: 3 files, 3.0 KiB
+Total: 3 files, 3.0 KiB
+{"time":"","event":"start","path":"/mnt/fs","total":0,"finished":0,"errors":0,"elapsed_seconds":0}
+{"time":"","event":"progress","path":"/mnt/fs","total":3,"finished":1,"errors":0,"elapsed_seconds":1}
+{"time":"","event":"failed","path":"/mnt/fs","total":3,"finished":2,"errors":1,"elapsed_seconds":2.5,"message":"1 files warmup failed"}
+`
+	result := parseWarmupOutput("host1", out, fmt.Errorf("exit status 1"))
+	assert.Equal("host1", result.host)
+	assert.Equal(int64(3), result.files)
+	assert.Equal(int64(2), result.finished)
+	assert.Equal(int64(1), result.errors)
+	assert.Equal(2.5, result.elapsed)
+	assert.Equal("1 files warmup failed", result.message)
+
+	result = parseWarmupOutput("host2", "bash: dingo: command not found\n", fmt.Errorf("exit status 127"))
+	assert.Equal("bash: dingo: command not found", result.message)
+	assert.Equal(int64(0), result.files)
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dingodb/dingocli/pkg/logger"
+)
+
+const (
+	EVENT_START    = "start"
+	EVENT_PROGRESS = "progress"
+	EVENT_DONE     = "done"
+	EVENT_FAILED   = "failed"
+	EVENT_TIMEOUT  = "timeout"
+)
+
+type warmupEvent struct {
+	Time     string  `json:"time"`
+	Event    string  `json:"event"`
+	Path     string  `json:"path"`
+	Total    int64   `json:"total"`
+	Finished int64   `json:"finished"`
+	Errors   int64   `json:"errors"`
+	Elapsed  float64 `json:"elapsed_seconds"`
+	Message  string  `json:"message,omitempty"`
+}
+
+type warmupJob struct {
+	path     string
+	started  bool
+	done     bool
+	total    int64
+	finished int64
+	errors   int64
+}
+
+type progressFunc func(path string) (int64, int64, int64, error)
+
+type warmupWaiter struct {
+	out      io.Writer
+	timeout  time.Duration
+	interval time.Duration
+	progress progressFunc
+	begin    time.Time
+}
+
+func newWarmupWaiter(out io.Writer, timeout, interval time.Duration) *warmupWaiter {
+	return &warmupWaiter{
+		out:      out,
+		timeout:  timeout,
+		interval: interval,
+		progress: getWarmupProgress,
+	}
+}
+
+func (w *warmupWaiter) emit(event string, job *warmupJob, message string) {
+	data, _ := json.Marshal(&warmupEvent{
+		Time:     time.Now().Format(time.RFC3339),
+		Event:    event,
+		Path:     job.path,
+		Total:    job.total,
+		Finished: job.finished,
+		Errors:   job.errors,
+		Elapsed:  time.Since(w.begin).Seconds(),
+		Message:  message,
+	})
+	fmt.Fprintln(w.out, string(data))
+}
+
+// poll the progress of job, return true if the job is done
+func (w *warmupWaiter) poll(job *warmupJob) (bool, error) {
+	total, finished, errors, err := w.progress(job.path)
+	if err != nil {
+		return false, err
+	}
+
+	logger.Infof("warmup result: path[%s], total[%d], finished[%d], errors[%d]",
+		job.path, total, finished, errors)
+	// the job is accepted once setting warmup xattr succeeded, so the empty
+	// progress means it is finished, maybe even before the first poll
+	if total == 0 {
+		job.finished = job.total - job.errors
+		return true, nil
+	}
+
+	job.started = true
+	job.total, job.finished, job.errors = total, finished, errors
+	return finished+errors >= total, nil
+}
+
+// wait blocks until all warmup jobs are done, it returns error if any job
+// failed, timed out or the progress can't be read
+func (w *warmupWaiter) wait(paths []string) error {
+	w.begin = time.Now()
+	jobs := []*warmupJob{}
+	for _, path := range paths {
+		job := &warmupJob{path: path}
+		jobs = append(jobs, job)
+		w.emit(EVENT_START, job, "")
+	}
+
+	failed := 0
+	pending := len(jobs)
+	for {
+		for _, job := range jobs {
+			if job.done {
+				continue
+			}
+
+			done, err := w.poll(job)
+			if err != nil {
+				job.done = true
+				failed++
+				pending--
+				w.emit(EVENT_FAILED, job, err.Error())
+				continue
+			} else if !done {
+				w.emit(EVENT_PROGRESS, job, "")
+				continue
+			}
+
+			job.done = true
+			pending--
+			if job.errors > 0 {
+				failed++
+				w.emit(EVENT_FAILED, job, fmt.Sprintf("%d files warmup failed", job.errors))
+			} else if !job.started {
+				w.emit(EVENT_DONE, job, "finished before the first poll")
+			} else {
+				w.emit(EVENT_DONE, job, "")
+			}
+		}
+
+		if pending == 0 {
+			break
+		} else if w.timeout > 0 && time.Since(w.begin) >= w.timeout {
+			for _, job := range jobs {
+				if !job.done {
+					w.emit(EVENT_TIMEOUT, job, fmt.Sprintf("warmup not finished in %s", w.timeout))
+				}
+			}
+			return fmt.Errorf("wait warmup timeout after %s, %d job(s) not finished", w.timeout, pending)
+		}
+		time.Sleep(w.interval)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d warmup job(s) failed", failed)
+	}
+	return nil
+}
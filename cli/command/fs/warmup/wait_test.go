@@ -0,0 +1,98 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package warmup
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProgress returns the given [total, finished, errors] in order
+// and keeps returning the last one
+func fakeProgress(results ...[3]int64) progressFunc {
+	i := 0
+	return func(path string) (int64, int64, int64, error) {
+		r := results[i]
+		if i < len(results)-1 {
+			i++
+		}
+		return r[0], r[1], r[2], nil
+	}
+}
+
+func decodeEvents(t *testing.T, out *bytes.Buffer) []warmupEvent {
+	events := []warmupEvent{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var event warmupEvent
+		assert.NoError(t, json.Unmarshal([]byte(line), &event))
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestWaitDone(t *testing.T) {
+	var out bytes.Buffer
+	waiter := newWarmupWaiter(&out, 0, time.Millisecond)
+	waiter.progress = fakeProgress([3]int64{10, 2, 0}, [3]int64{10, 8, 0}, [3]int64{0, 0, 0})
+
+	assert.NoError(t, waiter.wait([]string{"/mnt/dir1"}))
+	events := decodeEvents(t, &out)
+	assert.Equal(t, EVENT_START, events[0].Event)
+	assert.Equal(t, EVENT_PROGRESS, events[1].Event)
+	last := events[len(events)-1]
+	assert.Equal(t, EVENT_DONE, last.Event)
+	assert.Equal(t, int64(10), last.Total)
+	assert.Equal(t, int64(10), last.Finished)
+}
+
+func TestWaitFailed(t *testing.T) {
+	var out bytes.Buffer
+	waiter := newWarmupWaiter(&out, 0, time.Millisecond)
+	waiter.progress = fakeProgress([3]int64{10, 7, 3})
+
+	assert.Error(t, waiter.wait([]string{"/mnt/dir1"}))
+	events := decodeEvents(t, &out)
+	assert.Equal(t, EVENT_FAILED, events[len(events)-1].Event)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	var out bytes.Buffer
+	waiter := newWarmupWaiter(&out, 10*time.Millisecond, time.Millisecond)
+	waiter.progress = fakeProgress([3]int64{10, 1, 0})
+
+	assert.Error(t, waiter.wait([]string{"/mnt/dir1", "/mnt/dir2"}))
+	events := decodeEvents(t, &out)
+	assert.Equal(t, EVENT_TIMEOUT, events[len(events)-1].Event)
+	assert.Equal(t, EVENT_TIMEOUT, events[len(events)-2].Event)
+}
+
+func TestWaitFinishedBeforeFirstPoll(t *testing.T) {
+	var out bytes.Buffer
+	waiter := newWarmupWaiter(&out, 0, time.Millisecond)
+	waiter.progress = fakeProgress([3]int64{0, 0, 0})
+
+	assert.NoError(t, waiter.wait([]string{"/mnt/dir1", "/mnt/dir2"}))
+	events := decodeEvents(t, &out)
+	assert.Len(t, events, 4)
+	for _, event := range events[2:] {
+		assert.Equal(t, EVENT_DONE, event.Event)
+		assert.Equal(t, "finished before the first poll", event.Message)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package history
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/storage"
+	"github.com/dingodb/dingocli/internal/tui"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+type listOptions struct {
+	tail int
+	all  bool
+}
+
+func NewListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     "ls [OPTIONS]",
+		Aliases: []string{"list"},
+		Short:   "List playbook runs",
+		Args:    cliutil.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.IntVarP(&options.tail, "tail", "n", 20, "Number of runs to show from the end of the history (0 means all)")
+	flags.BoolVar(&options.all, "all", false, "List runs of all clusters")
+
+	return cmd
+}
+
+func runList(dingocli *cli.DingoCli, options listOptions) error {
+	runs, err := dingocli.Storage().GetPlaybookRuns()
+	if err != nil {
+		return errno.ERR_SELECT_PLAYBOOK_RUN_FAILED.E(err)
+	}
+
+	// runs of current cluster
+	if !options.all && dingocli.ClusterId() != -1 {
+		items := []storage.PlaybookRun{}
+		for _, run := range runs {
+			if run.ClusterId == dingocli.ClusterId() {
+				items = append(items, run)
+			}
+		}
+		runs = items
+	}
+
+	tail := options.tail
+	if tail > 0 && tail < len(runs) {
+		runs = runs[len(runs)-tail:]
+	}
+	output := tui.FormatPlaybookRuns(runs)
+	dingocli.WriteOut(output)
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package history
+
+import (
+	"strconv"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/tui"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var (
+	SHOW_EXAMPLE = `Examples:
+  $ dingo history show 12             # Show steps and tasks of run 12
+  $ dingo history show 12 --failed -v # Show failed tasks of run 12 with their output`
+)
+
+type showOptions struct {
+	runId      int64
+	failedOnly bool
+	verbose    bool
+}
+
+func NewShowCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options showOptions
+
+	cmd := &cobra.Command{
+		Use:     "show RUN_ID [OPTIONS]",
+		Short:   "Show steps and tasks of playbook run",
+		Args:    cliutil.ExactArgs(1),
+		Example: SHOW_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runId, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return errno.ERR_INVALID_PLAYBOOK_RUN_ID.F("run id: %s", args[0])
+			}
+			options.runId = runId
+			return runShow(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&options.failedOnly, "failed", false, "Only show failed tasks")
+	flags.BoolVarP(&options.verbose, "verbose", "v", false, "Show stdout and stderr of tasks")
+
+	return cmd
+}
+
+func displayRun(dingocli *cli.DingoCli, options showOptions) error {
+	storage := dingocli.Storage()
+	runs, err := storage.GetPlaybookRun(options.runId)
+	if err != nil {
+		return errno.ERR_SELECT_PLAYBOOK_RUN_FAILED.E(err)
+	} else if len(runs) == 0 {
+		return errno.ERR_PLAYBOOK_RUN_NOT_FOUND.F("run id: %d", options.runId)
+	}
+
+	run := runs[0]
+	command := "-"
+	if run.AuditId >= 0 {
+		auditLogs, err := storage.GetAuditLog(run.AuditId)
+		if err != nil {
+			return errno.ERR_GET_AUDIT_LOGS_FAILE.E(err)
+		} else if len(auditLogs) > 0 {
+			command = auditLogs[0].Command
+		}
+	}
+
+	dingocli.WriteOutln("Run Id    : %d", run.Id)
+	dingocli.WriteOutln("Operation : %s", run.Operation)
+	dingocli.WriteOutln("Status    : %s", tui.FormatPlaybookStatus(run.Status))
+	dingocli.WriteOutln("Start Time: %s", run.CreateTime.Format("2006-01-02 15:04:05"))
+	dingocli.WriteOutln("End Time  : %s", run.EndTime.Format("2006-01-02 15:04:05"))
+	dingocli.WriteOutln("Audit Id  : %d", run.AuditId)
+	dingocli.WriteOutln("Command   : %s", command)
+	dingocli.WriteOutln("")
+	return nil
+}
+
+func runShow(dingocli *cli.DingoCli, options showOptions) error {
+	// 1) display run
+	err := displayRun(dingocli, options)
+	if err != nil {
+		return err
+	}
+
+	// 2) display steps and tasks
+	steps, err := dingocli.Storage().GetPlaybookSteps(options.runId)
+	if err != nil {
+		return errno.ERR_SELECT_PLAYBOOK_STEPS_FAILED.E(err)
+	}
+	tasks, err := dingocli.Storage().GetPlaybookTasks(options.runId)
+	if err != nil {
+		return errno.ERR_SELECT_PLAYBOOK_TASKS_FAILED.E(err)
+	}
+
+	output := tui.FormatPlaybookSteps(steps, tasks, options.failedOnly, options.verbose)
+	if len(output) == 0 {
+		dingocli.WriteOutln(color.GreenString("No failed tasks"))
+		return nil
+	}
+	dingocli.WriteOut(output)
+	return nil
+}
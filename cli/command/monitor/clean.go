@@ -74,6 +74,7 @@ func NewCleanCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.host, "host", "*", "Specify monitor service host")
 	flags.StringSliceVarP(&options.only, "only", "o", CLEAN_ITEMS, "Specify clean item")
 	flags.BoolVarP(&options.force, "force", "f", false, "Force to clean without confirmation")
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -79,6 +79,7 @@ func NewDeployCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags := cmd.Flags()
 	flags.StringVarP(&options.filename, "conf", "c", "monitor.yaml", "Specify monitor configuration file")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -55,6 +55,7 @@ func NewRestartCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.role, "role", "*", "Specify monitor service role")
 	flags.StringVar(&options.host, "host", "*", "Specify monitor service host")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -61,6 +61,7 @@ func NewStatusCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.role, "role", "*", "Specify monitor service role")
 	flags.StringVar(&options.host, "host", "*", "Specify monitor service host")
 	flags.BoolVarP(&options.verbose, "verbose", "v", false, "Verbose output for status")
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
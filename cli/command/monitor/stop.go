@@ -57,6 +57,7 @@ func NewStopCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Force to stop without confirmation")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
@@ -67,6 +67,7 @@ func NewUpgradeCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
 
+	cliutil.SupportDryRun(cmd)
 	return cmd
 }
 
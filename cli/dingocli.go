@@ -35,6 +35,12 @@ func Execute() {
 	id := dingocli.PreAudit(time.Now(), os.Args[1:])
 	cmd := command.NewDingoCliCommand(dingocli)
 	err = cmd.Execute()
+	if e := dingocli.PrintDryRunPlan(); e != nil {
+		fmt.Println(e)
+		if err == nil {
+			err = e
+		}
+	}
 	dingocli.PostAudit(id, err)
 	if err != nil {
 		os.Exit(1)
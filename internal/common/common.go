@@ -143,6 +143,14 @@ const (
 
 	// upgrade
 	KEY_UPGRADE_FLAG = "UPGRADE_FLAG"
+	KEY_STORE_HEALTH = "STORE_HEALTH"
+
+	// backup
+	KEY_BACKUP_DIR      = "BACKUP_DIR"
+	KEY_RESTORE_FILE    = "RESTORE_FILE"
+	BACKUP_MANIFEST     = "manifest.json"
+	BACKUP_KIND_ETCD    = "etcd"
+	BACKUP_KIND_MDSMETA = "mds-meta"
 
 	// env
 	KEY_ENV_MDS_ADDR = "cluster_mds_addr"
@@ -159,6 +167,14 @@ const (
 	AUDIT_STATUS_CANCEL
 )
 
+// playbook run/step/task status
+const (
+	PLAYBOOK_STATUS_PENDING = iota
+	PLAYBOOK_STATUS_RUNNING
+	PLAYBOOK_STATUS_SUCCESS
+	PLAYBOOK_STATUS_FAIL
+)
+
 type RpcOpions struct {
 	RetryTimes int32
 	RetryDelay time.Duration
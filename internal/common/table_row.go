@@ -113,4 +113,35 @@ const (
 
 	// delete subdir
 	ROW_DELETE_INODES = "delete inodes"
+
+	// warmup profile
+	ROW_FILTER   = "filter"
+	ROW_TARGET   = "target"
+	ROW_SCHEDULE = "schedule"
+	ROW_HOST     = "host"
+	ROW_FILES    = "files"
+	ROW_FINISHED = "finished"
+	ROW_ERRORS   = "errors"
+	ROW_ELAPSED  = "elapsed"
+
+	// cache usage
+	ROW_CACHED         = "cached"
+	ROW_CACHED_PERCENT = "cached%"
+
+	// cache group rebalance
+	ROW_NEW_WEIGHT = "new weight"
+
+	// cache status
+	ROW_PID      = "pid"
+	ROW_LISTEN   = "listen"
+	ROW_UPTIME   = "uptime"
+	ROW_RESTARTS = "restarts"
+
+	// cache disk
+	ROW_STAGE_FILES = "stage files"
+	ROW_STAGE_SIZE  = "stage size"
+	ROW_OLDEST      = "oldest"
+	ROW_NEWEST      = "newest"
+	ROW_REMOVED     = "removed"
+	ROW_FREED       = "freed"
 )
@@ -41,6 +41,27 @@ type ComponentManager struct {
 	avaliable     []*Component
 	repodata      map[string]*BinaryRepoData
 	mirror        string
+	lock          *ComponentsLock
+}
+
+// only load the installed components, no remote repostory required
+func NewLocalComponentManager() (*ComponentManager, error) {
+	if err := os.MkdirAll(RepostoryDir, 0755); err != nil {
+		panic(fmt.Sprintf("Failed to create config directory: %v", err))
+	}
+
+	ComponentManager := &ComponentManager{
+		rootDir:       RepostoryDir,
+		installedFile: filepath.Join(RepostoryDir, INSTALLED_FILE),
+		repodata:      make(map[string]*BinaryRepoData),
+		mirror:        GetMirrorURL(RepostoryDir),
+	}
+
+	if _, err := ComponentManager.LoadInstalledComponents(); err != nil {
+		return nil, err
+	}
+
+	return ComponentManager, nil
 }
 
 func NewComponentManager() (*ComponentManager, error) {
@@ -52,12 +73,12 @@ func NewComponentManager() (*ComponentManager, error) {
 		rootDir:       RepostoryDir,
 		installedFile: filepath.Join(RepostoryDir, INSTALLED_FILE),
 		repodata:      make(map[string]*BinaryRepoData),
-		mirror:        Mirror_URL,
+		mirror:        GetMirrorURL(RepostoryDir),
 	}
 
 	//load remote repostory
 	for _, name := range ALL_COMPONENTS {
-		repodata, err := NewBinaryRepoData(Mirror_URL, name)
+		repodata, err := NewBinaryRepoData(ComponentManager.mirror, name)
 		if err != nil {
 			return nil, err
 		}
@@ -180,8 +201,21 @@ func (cm *ComponentManager) FindVersion(name, version string) (string, *BinaryDe
 
 	default:
 		binaryDetail, ok = repodata.FindVersion(version)
+		if ok || !IsConstraint(version) {
+			if !ok {
+				return "", nil, fmt.Errorf("%s: version '%s' not found", name, version)
+			}
+			break
+		}
+
+		// version constraint, e.g. ~1.2, ^1.0 or ">=1.3 <2"
+		constraint, err := ParseConstraint(version)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: %w", name, err)
+		}
+		foundVersion, binaryDetail, ok = repodata.FindConstraint(constraint)
 		if !ok {
-			return "", nil, fmt.Errorf("%s: version '%s' not found", name, version)
+			return "", nil, fmt.Errorf("%s: no version matches '%s'", name, version)
 		}
 	}
 
@@ -202,6 +236,11 @@ func (cm *ComponentManager) installOrUpdateComponent(name, version string, isUpd
 		return nil, err
 	}
 
+	// refuse the binary which differs from the locked one before download
+	if err := cm.checkLocked(name, foundVersion, binaryDetail.Sha256); err != nil {
+		return nil, err
+	}
+
 	// check if is installed
 	existingComp, err := cm.FindInstallComponent(name, foundVersion)
 	if err != nil && !errors.Is(err, ErrNotFound) {
@@ -229,55 +268,60 @@ func (cm *ComponentManager) installOrUpdateComponent(name, version string, isUpd
 		Commit:      binaryDetail.Commit,
 		Release:     binaryDetail.BuildTime,
 		IsInstalled: true,
-		Path:        filepath.Join(cm.rootDir, name, foundVersion),
+		Path:        filepath.Join(cm.rootDir, name, foundVersion, buildDirName(binaryDetail.BuildTime)),
 		URL:         URLJoin(cm.mirror, binaryDetail.Path),
 	}
 
 	fmt.Printf("Download %s from %s\n", name, newComponent.URL)
 
-	err = utils.DownloadFileWithProgress(newComponent.URL, newComponent.Path, newComponent.Name)
+	// download to a temporary file, replace the binary only after verified
+	downloadName := fmt.Sprintf("%s.download", newComponent.Name)
+	err = utils.DownloadFileWithProgress(newComponent.URL, newComponent.Path, downloadName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download %s: %v", name, err)
 	}
 
-	// for update, if already exists, replace old
-	if isUpdate && existingComp != nil {
-		for i, comp := range cm.installed {
-			if comp.Name == name && comp.Version == foundVersion {
-				cm.installed[i] = newComponent
-				break
-			}
-		}
-	} else {
-		cm.installed = append(cm.installed, newComponent)
+	downloadFile := filepath.Join(newComponent.Path, downloadName)
+	digest, err := cm.verifyDownload(downloadFile, binaryDetail)
+	if err == nil {
+		err = cm.checkLocked(name, foundVersion, digest)
+	}
+	if err != nil {
+		os.Remove(downloadFile)
+		return nil, fmt.Errorf("failed to verify %s: %w", name, err)
 	}
+	newComponent.Sha256 = digest
 
-	// set as default version
-	if err := cm.SetDefaultVersion(name, foundVersion); err != nil {
+	// the config templates are used to render service config, e.g. systemd deployment
+	if err := cm.installConfs(newComponent.Path, binaryDetail); err != nil {
+		os.Remove(downloadFile)
+		os.RemoveAll(filepath.Join(newComponent.Path, COMPONENT_CONF_DIR))
+		return nil, err
+	}
+
+	if err := os.Rename(downloadFile, filepath.Join(newComponent.Path, newComponent.Name)); err != nil {
 		return nil, err
 	}
 
+	// every build is kept in its own directory, the old one is only removed by prune
+	cm.installed = append(cm.installed, newComponent)
+
+	// set as default version
+	cm.activate(newComponent)
+
 	return newComponent, cm.SaveInstalledComponents()
 }
 
+// activate the latest build of the version
 func (cm *ComponentManager) SetDefaultVersion(name, version string) error {
-	found := false
-
-	for i := range cm.installed {
-		if cm.installed[i].Name == name {
-			if cm.installed[i].Version == version {
-				cm.installed[i].IsActive = true
-				found = true
-			} else {
-				cm.installed[i].IsActive = false
-			}
-		}
-	}
-
-	if !found {
+	comp, err := cm.FindInstallComponent(name, version)
+	if err != nil {
 		return fmt.Errorf("component %s:%s not installed", name, version)
 	}
 
+	if !comp.IsActive {
+		cm.activate(comp)
+	}
 	return nil
 }
 
@@ -289,12 +333,15 @@ func (cm *ComponentManager) RemoveComponent(name, version string, force bool, sa
 		if (comp.Name == name && comp.Version == version) && comp.IsActive && !force {
 			return fmt.Errorf("cannot remove active component %s, please set another version as default or use --force to remove", name)
 		}
+	}
 
+	for _, comp := range cm.installed {
 		if !(comp.Name == name && comp.Version == version) {
 			newComponents = append(newComponents, comp)
 		} else {
 			filename = filepath.Join(comp.Path, name)
 			os.Remove(filename)
+			os.Remove(comp.Path) // build directory, only if empty
 		}
 	}
 
@@ -366,14 +413,21 @@ func (cm *ComponentManager) ListComponents() ([]*Component, error) {
 	return allComponents, nil
 }
 
+// the latest build of the version
 func (cm *ComponentManager) FindInstallComponent(name string, version string) (*Component, error) {
+	var found *Component
 	for _, comp := range cm.installed {
 		if comp.Name == name && comp.Version == version {
-			return comp, nil
+			if found == nil || comp.Release > found.Release {
+				found = comp
+			}
 		}
 	}
 
-	return nil, ErrNotFound
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return found, nil
 }
 
 func (cm *ComponentManager) IsInstalled(name, version string) bool {
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package component
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+const (
+	COMPONENT_CONF_DIR = "conf"
+)
+
+// ConfPath returns the config template shipped with the build, e.g. <build>/conf/mds.template.conf
+func (c *Component) ConfPath(name string) string {
+	return filepath.Join(c.Path, COMPONENT_CONF_DIR, name)
+}
+
+func sortedConfNames(binaryDetail *BinaryDetail) []string {
+	names := []string{}
+	for name := range binaryDetail.Confs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// download the config template and verify it like the binary
+func (cm *ComponentManager) fetchConf(name string, conf ConfDetail) (string, error) {
+	if name != filepath.Base(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid config template name '%s'", name)
+	}
+	content, err := utils.GetRemoteFileContent(URLJoin(cm.mirror, conf.Path))
+	if err != nil {
+		return "", fmt.Errorf("failed to download config template %s: %w", name, err)
+	}
+	sum := sha256.Sum256([]byte(content))
+	if err := cm.verifyRepoDigest(conf.Path, hex.EncodeToString(sum[:]), conf.Sha256, conf.Signature); err != nil {
+		return "", fmt.Errorf("failed to verify config template %s: %w", name, err)
+	}
+	return content, nil
+}
+
+// install the config templates of build into <dir>/conf
+func (cm *ComponentManager) installConfs(dir string, binaryDetail *BinaryDetail) error {
+	for _, name := range sortedConfNames(binaryDetail) {
+		content, err := cm.fetchConf(name, binaryDetail.Confs[name])
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, COMPONENT_CONF_DIR, name)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		} else if err := os.WriteFile(target, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package component
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const TEST_MDS_TEMPLATE = "--mds_server_port=6900\n--log_level=INFO\n"
+
+// add config template mds.template.conf into v1.0.0 of dingo-mds in mirror
+func addMirrorConf(t *testing.T, mirror string, conf ConfDetail) {
+	filename := filepath.Join(mirror, versionFilename(DINGO_MDS))
+	repodata, err := ParseFromFile(filename)
+	require.NoError(t, err)
+	detail := repodata.Tags["v1.0.0"]
+	detail.Confs = map[string]ConfDetail{"mds.template.conf": conf}
+	repodata.Tags["v1.0.0"] = detail
+	require.NoError(t, writeBinaryRepoData(filename, repodata))
+}
+
+func newMirrorComponentManager(t *testing.T, mirror string) *ComponentManager {
+	os.Unsetenv("DINGOFS_MIRROR_PUBLIC_KEY")
+	rootDir := t.TempDir()
+	cm := &ComponentManager{
+		rootDir:       rootDir,
+		installedFile: filepath.Join(rootDir, INSTALLED_FILE),
+		mirror:        "file://" + mirror,
+		repodata:      map[string]*BinaryRepoData{},
+	}
+	repodata, err := NewBinaryRepoData(cm.mirror, DINGO_MDS)
+	require.NoError(t, err)
+	cm.repodata[DINGO_MDS] = repodata
+	return cm
+}
+
+func TestComponentManager_InstallConfs(t *testing.T) {
+	mirror := newLocalMirror(t)
+	path := "/v1.0.0/conf/mds.template.conf"
+	require.NoError(t, os.MkdirAll(filepath.Join(mirror, "v1.0.0", "conf"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(mirror, path), []byte(TEST_MDS_TEMPLATE), 0644))
+	addMirrorConf(t, mirror, ConfDetail{Path: path, Sha256: sha256Hex([]byte(TEST_MDS_TEMPLATE))})
+
+	// the template is installed beside the binary
+	cm := newMirrorComponentManager(t, mirror)
+	comp, err := cm.InstallComponent(DINGO_MDS, "v1.0.0")
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(comp.Path, comp.Name))
+	data, err := os.ReadFile(comp.ConfPath("mds.template.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, TEST_MDS_TEMPLATE, string(data))
+
+	// the mirror pulled keeps the template
+	dir := t.TempDir()
+	_, err = cm.PullComponents(dir, []string{DINGO_MDS}, []string{"v1.0.0"})
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, path))
+
+	// the template is tampered, nothing installed
+	addMirrorConf(t, mirror, ConfDetail{Path: path, Sha256: sha256Hex([]byte("other"))})
+	cm = newMirrorComponentManager(t, mirror)
+	_, err = cm.InstallComponent(DINGO_MDS, "v1.0.0")
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+	assert.False(t, cm.IsInstalled(DINGO_MDS, "v1.0.0"))
+
+	// the name of template must be a plain file name
+	_, err = cm.fetchConf("../mds.template.conf", ConfDetail{Path: path})
+	assert.Error(t, err)
+}
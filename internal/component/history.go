@@ -0,0 +1,168 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// fixed width so that activations can be compared as string
+const ACTIVATION_TIME_FORMAT = "2006-01-02T15:04:05.000000000Z07:00"
+
+// one activation of a build, for "component history"
+type Activation struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Release string `json:"release"`
+	Time    string `json:"time"`
+	Active  bool   `json:"active"`
+}
+
+// the directory name of build, e.g. "2026-01-02 15:04:05" => "20260102150405"
+func buildDirName(buildTime string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			return r
+		}
+		return -1
+	}, buildTime)
+	if len(name) == 0 {
+		name = time.Now().Format("20060102150405")
+	}
+	return name
+}
+
+func (c *Component) lastActivation() string {
+	if len(c.Activations) == 0 {
+		return ""
+	}
+	return c.Activations[len(c.Activations)-1]
+}
+
+// set the build as the only active one of component and record the activation
+func (cm *ComponentManager) activate(target *Component) {
+	for _, comp := range cm.installed {
+		if comp.Name == target.Name {
+			comp.IsActive = false
+		}
+	}
+	target.IsActive = true
+	target.Activations = append(target.Activations, time.Now().UTC().Format(ACTIVATION_TIME_FORMAT))
+}
+
+// activations of the component, the latest first
+func (cm *ComponentManager) History(name string) []*Activation {
+	history := []*Activation{}
+	for _, comp := range cm.installed {
+		if comp.Name != name {
+			continue
+		}
+		for _, t := range comp.Activations {
+			history = append(history, &Activation{
+				Name:    comp.Name,
+				Version: comp.Version,
+				Release: comp.Release,
+				Time:    t,
+				Active:  comp.IsActive && t == comp.lastActivation(),
+			})
+		}
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].Time > history[j].Time
+	})
+	return history
+}
+
+// re-activate the build which was active before the current one
+func (cm *ComponentManager) Rollback(name string) (*Component, *Component, error) {
+	current, err := cm.GetActiveComponent(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var previous *Component
+	for _, comp := range cm.installed {
+		if comp.Name != name || comp == current {
+			continue
+		}
+		if previous == nil || comp.lastActivation() > previous.lastActivation() ||
+			(comp.lastActivation() == previous.lastActivation() && comp.Release > previous.Release) {
+			previous = comp
+		}
+	}
+	if previous == nil {
+		return nil, nil, fmt.Errorf("no previous build of %s to rollback", name)
+	}
+
+	cm.activate(previous)
+	return current, previous, nil
+}
+
+// remove the builds of component except the active one and the latest used keep builds
+func (cm *ComponentManager) Prune(name string, keep int) ([]*Component, error) {
+	if keep < 1 {
+		return nil, fmt.Errorf("keep must be greater than 0")
+	}
+
+	builds := map[string][]*Component{}
+	for _, comp := range cm.installed {
+		if len(name) == 0 || comp.Name == name {
+			builds[comp.Name] = append(builds[comp.Name], comp)
+		}
+	}
+	if len(name) > 0 && len(builds) == 0 {
+		return nil, fmt.Errorf("component %s not installed", name)
+	}
+
+	pruned := map[*Component]bool{}
+	for _, comps := range builds {
+		// the active build first, then the latest used or released
+		sort.SliceStable(comps, func(i, j int) bool {
+			if comps[i].IsActive != comps[j].IsActive {
+				return comps[i].IsActive
+			}
+			if comps[i].lastActivation() != comps[j].lastActivation() {
+				return comps[i].lastActivation() > comps[j].lastActivation()
+			}
+			return comps[i].Release > comps[j].Release
+		})
+		for _, comp := range comps[min(keep, len(comps)):] {
+			pruned[comp] = true
+		}
+	}
+
+	removed := []*Component{}
+	installed := []*Component{}
+	for _, comp := range cm.installed {
+		if !pruned[comp] {
+			installed = append(installed, comp)
+			continue
+		}
+		if err := os.Remove(filepath.Join(comp.Path, comp.Name)); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		os.Remove(comp.Path) // build directory, only if empty
+		removed = append(removed, comp)
+	}
+	cm.installed = installed
+
+	return removed, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHistoryComponentManager(t *testing.T) *ComponentManager {
+	rootDir := t.TempDir()
+	builds := []*Component{
+		{Name: DINGO_CLIENT, Version: "v1.0.0", Release: "20250101000000", Activations: []string{"2025-01-01T00:00:00.000000000Z"}},
+		{Name: DINGO_CLIENT, Version: "v1.1.0", Release: "20250201000000", Activations: []string{"2025-02-01T00:00:00.000000000Z"}},
+		{Name: DINGO_CLIENT, Version: "v1.1.0", Release: "20250301000000", Activations: []string{"2025-03-01T00:00:00.000000000Z"}, IsActive: true},
+		{Name: DINGO_MDS, Version: "v1.0.0", Release: "20250101000000", IsActive: true},
+	}
+	for _, comp := range builds {
+		comp.IsInstalled = true
+		comp.Path = filepath.Join(rootDir, comp.Name, comp.Version, buildDirName(comp.Release))
+		require.NoError(t, os.MkdirAll(comp.Path, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(comp.Path, comp.Name), []byte(comp.Release), 0755))
+	}
+
+	return &ComponentManager{
+		rootDir:       rootDir,
+		installed:     builds,
+		installedFile: filepath.Join(rootDir, INSTALLED_FILE),
+	}
+}
+
+func TestBuildDirName(t *testing.T) {
+	assert.Equal(t, "20250101120000", buildDirName("2025-01-01 12:00:00"))
+	assert.Equal(t, "build42", buildDirName("build #42"))
+	assert.Len(t, buildDirName(""), len("20060102150405"))
+}
+
+func TestComponentManager_Rollback(t *testing.T) {
+	cm := newHistoryComponentManager(t)
+
+	current, previous, err := cm.Rollback(DINGO_CLIENT)
+	require.NoError(t, err)
+	assert.Equal(t, "20250301000000", current.Release)
+	assert.Equal(t, "20250201000000", previous.Release)
+	assert.False(t, current.IsActive)
+	assert.True(t, previous.IsActive)
+
+	// rollback twice goes back to the build activated before
+	_, previous, err = cm.Rollback(DINGO_CLIENT)
+	require.NoError(t, err)
+	assert.Equal(t, "20250301000000", previous.Release)
+
+	history := cm.History(DINGO_CLIENT)
+	require.Len(t, history, 5)
+	assert.Equal(t, "20250301000000", history[0].Release)
+	assert.True(t, history[0].Active)
+	assert.False(t, history[1].Active)
+
+	_, _, err = cm.Rollback(DINGO_MDS)
+	assert.Error(t, err)
+	_, _, err = cm.Rollback("not-exist")
+	assert.Error(t, err)
+}
+
+func TestComponentManager_SetDefaultVersionRecordsActivation(t *testing.T) {
+	cm := newHistoryComponentManager(t)
+
+	require.NoError(t, cm.SetDefaultVersion(DINGO_CLIENT, "v1.0.0"))
+	active, err := cm.GetActiveComponent(DINGO_CLIENT)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0", active.Version)
+	assert.Len(t, active.Activations, 2)
+
+	assert.Error(t, cm.SetDefaultVersion(DINGO_CLIENT, "v9.9.9"))
+	assert.Equal(t, "v1.0.0", cm.History(DINGO_CLIENT)[0].Version)
+}
+
+func TestComponentManager_Prune(t *testing.T) {
+	cm := newHistoryComponentManager(t)
+
+	_, err := cm.Prune(DINGO_CLIENT, 0)
+	assert.Error(t, err)
+	_, err = cm.Prune("not-exist", 1)
+	assert.Error(t, err)
+
+	removed, err := cm.Prune("", 2)
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	assert.Equal(t, "20250101000000", removed[0].Release)
+	assert.NoDirExists(t, removed[0].Path)
+	assert.Len(t, cm.installed, 3)
+
+	// the active build is always kept
+	require.NoError(t, cm.SetDefaultVersion(DINGO_CLIENT, "v1.1.0"))
+	_, _, err = cm.Rollback(DINGO_CLIENT)
+	require.NoError(t, err)
+	removed, err = cm.Prune(DINGO_CLIENT, 1)
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	active, err := cm.GetActiveComponent(DINGO_CLIENT)
+	require.NoError(t, err)
+	assert.Equal(t, "20250201000000", active.Release)
+	assert.FileExists(t, filepath.Join(active.Path, DINGO_CLIENT))
+}
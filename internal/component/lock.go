@@ -0,0 +1,153 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	LOCK_FILE = "components.lock"
+)
+
+// the exact version resolved for a component, pinned in components.lock
+type LockedComponent struct {
+	Version    string `json:"version"`
+	Constraint string `json:"constraint,omitempty"`
+	Commit     string `json:"commit,omitempty"`
+	Sha256     string `json:"sha256,omitempty"`
+}
+
+type ComponentsLock struct {
+	Cluster    string                      `json:"cluster"`
+	UpdatedAt  string                      `json:"updated_at"`
+	Components map[string]*LockedComponent `json:"components"`
+}
+
+// every cluster has its own lock file: ~/.dingo/components/clusters/<cluster>/components.lock
+func LockFilePath(cluster string) string {
+	return filepath.Join(RepostoryDir, "clusters", cluster, LOCK_FILE)
+}
+
+func LoadLock(filename, cluster string) (*ComponentsLock, error) {
+	lock := &ComponentsLock{
+		Cluster:    cluster,
+		Components: map[string]*LockedComponent{},
+	}
+
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return lock, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read lock file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, lock); err != nil {
+		return nil, fmt.Errorf("failed to parse lock file %s: %w", filename, err)
+	}
+	if lock.Components == nil {
+		lock.Components = map[string]*LockedComponent{}
+	}
+	return lock, nil
+}
+
+func (l *ComponentsLock) Save(filename string) error {
+	l.UpdatedAt = time.Now().Format(time.RFC3339)
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal lock: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0644)
+}
+
+func (l *ComponentsLock) Get(name string) (*LockedComponent, bool) {
+	if l == nil {
+		return nil, false
+	}
+	locked, ok := l.Components[name]
+	return locked, ok
+}
+
+// resolve the requested version with the pinned one, the request must
+// be empty, the pinned version or a constraint which the pinned version satisfies
+func (l *ComponentsLock) Resolve(name, version string) (string, error) {
+	locked, ok := l.Get(name)
+	if !ok {
+		return version, nil
+	}
+
+	switch {
+	case len(version) == 0 || version == locked.Version:
+		return locked.Version, nil
+	case IsConstraint(version):
+		constraint, err := ParseConstraint(version)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", name, err)
+		}
+		v, err := ParseVersion(locked.Version)
+		if err == nil && constraint.Check(v) {
+			return locked.Version, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s is locked to %s in %s, but %s is requested", name, locked.Version, LOCK_FILE, version)
+}
+
+// resolve the version and pin it into the lock
+func (cm *ComponentManager) LockComponent(lock *ComponentsLock, name, version string) (*LockedComponent, error) {
+	if len(version) == 0 {
+		version = LASTEST_VERSION
+	}
+	foundVersion, binaryDetail, err := cm.FindVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := &LockedComponent{
+		Version: foundVersion,
+		Commit:  binaryDetail.Commit,
+		Sha256:  binaryDetail.Sha256,
+	}
+	if IsConstraint(version) || version == LASTEST_VERSION {
+		locked.Constraint = version
+	}
+	lock.Components[name] = locked
+	return locked, nil
+}
+
+func (cm *ComponentManager) UseLock(lock *ComponentsLock) {
+	cm.lock = lock
+}
+
+// check the binary against the digest pinned in lock
+func (cm *ComponentManager) checkLocked(name, version, digest string) error {
+	locked, ok := cm.lock.Get(name)
+	if !ok || locked.Version != version || len(locked.Sha256) == 0 || len(digest) == 0 {
+		return nil
+	}
+	if !strings.EqualFold(locked.Sha256, digest) {
+		return fmt.Errorf("%w: %s:%s is locked with sha256 %s, got %s",
+			ErrDigestMismatch, name, version, locked.Sha256, digest)
+	}
+	return nil
+}
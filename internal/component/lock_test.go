@@ -0,0 +1,114 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponentsLock_SaveAndLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "cluster1", LOCK_FILE)
+
+	lock, err := LoadLock(filename, "cluster1")
+	require.NoError(t, err)
+	assert.Empty(t, lock.Components)
+
+	lock.Components[DINGO_CLIENT] = &LockedComponent{Version: "v1.2.3", Constraint: "~1.2", Sha256: "abc"}
+	require.NoError(t, lock.Save(filename))
+
+	loaded, err := LoadLock(filename, "cluster1")
+	require.NoError(t, err)
+	assert.Equal(t, "cluster1", loaded.Cluster)
+	assert.Equal(t, lock.Components, loaded.Components)
+
+	require.NoError(t, os.WriteFile(filename, []byte("{"), 0644))
+	_, err = LoadLock(filename, "cluster1")
+	assert.Error(t, err)
+}
+
+func TestComponentsLock_Resolve(t *testing.T) {
+	var lock *ComponentsLock
+	version, err := lock.Resolve(DINGO_CLIENT, "v1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.0.0", version)
+
+	lock = &ComponentsLock{Components: map[string]*LockedComponent{
+		DINGO_CLIENT: {Version: "v1.2.3"},
+	}}
+	tests := []struct {
+		version  string
+		expected string
+		hasError bool
+	}{
+		{"", "v1.2.3", false},
+		{"v1.2.3", "v1.2.3", false},
+		{"~1.2", "v1.2.3", false},
+		{"^2.0", "", true},
+		{"v1.2.4", "", true},
+		{LASTEST_VERSION, "", true},
+	}
+	for _, tt := range tests {
+		version, err := lock.Resolve(DINGO_CLIENT, tt.version)
+		if tt.hasError {
+			assert.Error(t, err, tt.version)
+		} else {
+			assert.NoError(t, err, tt.version)
+			assert.Equal(t, tt.expected, version)
+		}
+	}
+
+	// not locked
+	version, err = lock.Resolve(DINGO_MDS, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "", version)
+}
+
+func TestComponentManager_LockComponent(t *testing.T) {
+	cm := &ComponentManager{
+		repodata: map[string]*BinaryRepoData{
+			DINGO_CLIENT: {
+				Tags: map[string]BinaryDetail{
+					"v1.2.3": {Path: "/tags/v1.2.3", Commit: "c123", Sha256: "s123"},
+					"v1.3.0": {Path: "/tags/v1.3.0", Commit: "c130", Sha256: "s130"},
+				},
+			},
+		},
+	}
+	lock := &ComponentsLock{Components: map[string]*LockedComponent{}}
+
+	locked, err := cm.LockComponent(lock, DINGO_CLIENT, "~1.2")
+	require.NoError(t, err)
+	assert.Equal(t, &LockedComponent{Version: "v1.2.3", Constraint: "~1.2", Commit: "c123", Sha256: "s123"}, locked)
+
+	locked, err = cm.LockComponent(lock, DINGO_CLIENT, "")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.3.0", locked.Version)
+	assert.Equal(t, LASTEST_VERSION, locked.Constraint)
+	assert.Len(t, lock.Components, 1)
+
+	_, err = cm.LockComponent(lock, DINGO_MDS, "")
+	assert.Error(t, err)
+
+	// the binary must be the locked one
+	cm.UseLock(lock)
+	assert.NoError(t, cm.checkLocked(DINGO_CLIENT, "v1.3.0", "S130"))
+	assert.NoError(t, cm.checkLocked(DINGO_CLIENT, "v1.2.3", "other"))
+	assert.ErrorIs(t, cm.checkLocked(DINGO_CLIENT, "v1.3.0", "other"), ErrDigestMismatch)
+}
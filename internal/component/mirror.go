@@ -0,0 +1,297 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+const (
+	MIRROR_DIR  = "mirror"
+	MIRROR_FILE = "mirror.url"
+)
+
+// the mirror used by component manager: env DINGOFS_MIRROR > imported mirror > default
+func GetMirrorURL(rootDir string) string {
+	if val, ok := os.LookupEnv("DINGOFS_MIRROR"); ok {
+		return val
+	}
+
+	data, err := os.ReadFile(filepath.Join(rootDir, MIRROR_FILE))
+	if err == nil && len(strings.TrimSpace(string(data))) > 0 {
+		return strings.TrimSpace(string(data))
+	}
+	return Mirror_URL
+}
+
+func SetMirrorURL(rootDir, mirror string) error {
+	return os.WriteFile(filepath.Join(rootDir, MIRROR_FILE), []byte(mirror+"\n"), 0644)
+}
+
+func (cm *ComponentManager) GetMirror() string {
+	return cm.mirror
+}
+
+func versionFilename(name string) string {
+	return fmt.Sprintf("%s.version", name)
+}
+
+// pull the binaries of components into directory, the layout is the same as mirror
+func (cm *ComponentManager) PullComponents(dir string, names, versions []string) ([]*Component, error) {
+	if len(names) == 0 {
+		names = ALL_COMPONENTS
+	}
+	if len(versions) == 0 {
+		versions = []string{LASTEST_VERSION}
+	}
+
+	pulled := []*Component{}
+	for _, name := range ALL_COMPONENTS {
+		repodata := &BinaryRepoData{
+			Binary:   name,
+			Branches: map[string]BinaryDetail{},
+			Commits:  map[string]BinaryDetail{},
+			Tags:     map[string]BinaryDetail{},
+		}
+		if origin, ok := cm.repodata[name]; ok {
+			repodata.GeneratedAt = origin.GeneratedAt
+		}
+
+		// the metadata of components not pulled is kept empty,
+		// so the mirror is still loadable by component manager
+		for _, version := range versions {
+			if !utils.Contains(names, name) {
+				break
+			}
+
+			foundVersion, binaryDetail, err := cm.FindVersion(name, version)
+			if err != nil {
+				return nil, err
+			}
+
+			url := URLJoin(cm.mirror, binaryDetail.Path)
+			fmt.Printf("Download %s:%s from %s\n", name, foundVersion, url)
+			target := filepath.Join(dir, binaryDetail.Path)
+			err = utils.DownloadFileWithProgress(url, filepath.Dir(target), filepath.Base(target))
+			if err != nil {
+				return nil, fmt.Errorf("failed to download %s: %v", name, err)
+			}
+			if _, err := cm.verifyDownload(target, binaryDetail); err != nil {
+				return nil, fmt.Errorf("failed to verify %s: %w", name, err)
+			}
+
+			if err := cm.pullSignature(dir, binaryDetail.Signature); err != nil {
+				return nil, err
+			}
+			for _, name := range sortedConfNames(binaryDetail) {
+				conf := binaryDetail.Confs[name]
+				content, err := cm.fetchConf(name, conf)
+				if err != nil {
+					return nil, err
+				} else if err := writeMirrorFile(dir, conf.Path, content); err != nil {
+					return nil, err
+				} else if err := cm.pullSignature(dir, conf.Signature); err != nil {
+					return nil, err
+				}
+			}
+
+			if version == MAIN_VERSION {
+				repodata.Branches[MAIN_VERSION] = *binaryDetail
+			} else {
+				repodata.Tags[foundVersion] = *binaryDetail
+			}
+			pulled = append(pulled, &Component{
+				Name:    name,
+				Version: foundVersion,
+				Commit:  binaryDetail.Commit,
+				Release: binaryDetail.BuildTime,
+				URL:     url,
+				Sha256:  binaryDetail.Sha256,
+			})
+		}
+
+		if err := writeBinaryRepoData(filepath.Join(dir, versionFilename(name)), repodata); err != nil {
+			return nil, err
+		}
+	}
+
+	return pulled, nil
+}
+
+func (cm *ComponentManager) pullSignature(dir, path string) error {
+	if len(path) == 0 {
+		return nil
+	}
+	signature, err := utils.GetRemoteFileContent(URLJoin(cm.mirror, path))
+	if err != nil {
+		return fmt.Errorf("failed to download signature: %w", err)
+	}
+	return writeMirrorFile(dir, path, signature)
+}
+
+func writeMirrorFile(dir, path, content string) error {
+	target := filepath.Join(dir, path)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(target, []byte(content), 0644)
+}
+
+func writeBinaryRepoData(filename string, repodata *BinaryRepoData) error {
+	data, err := json.MarshalIndent(repodata, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal repo data: %w", err)
+	}
+	return os.WriteFile(filename, data, 0644)
+}
+
+// merge the versions of src into the metadata file, the newer one wins
+func mergeBinaryRepoData(filename string, src *BinaryRepoData) error {
+	dst, err := ParseFromFile(filename)
+	if os.IsNotExist(err) {
+		return writeBinaryRepoData(filename, src)
+	} else if err != nil {
+		return err
+	}
+
+	merge := func(dst *map[string]BinaryDetail, src map[string]BinaryDetail) {
+		if *dst == nil {
+			*dst = map[string]BinaryDetail{}
+		}
+		for k, v := range src {
+			(*dst)[k] = v
+		}
+	}
+	merge(&dst.Branches, src.Branches)
+	merge(&dst.Commits, src.Commits)
+	merge(&dst.Tags, src.Tags)
+	if src.GeneratedAt > dst.GeneratedAt {
+		dst.GeneratedAt = src.GeneratedAt
+	}
+	return writeBinaryRepoData(filename, dst)
+}
+
+// pack all files under directory into a tarball
+func PackBundle(dir, output string) error {
+	out, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	tw := tar.NewWriter(out)
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// extract the bundle into mirror directory, the metadata is merged with the existing one
+func ImportBundle(bundle, mirrorDir string) ([]string, error) {
+	in, err := os.Open(bundle)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(mirrorDir, 0755); err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	tr := tar.NewReader(in)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read bundle %s: %w", bundle, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("invalid file %s in bundle %s", header.Name, bundle)
+		}
+		target := filepath.Join(mirrorDir, name)
+
+		if strings.HasSuffix(name, ".version") && filepath.Dir(name) == "." {
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			repodata, err := ParseBinaryRepoData(data)
+			if err != nil {
+				return nil, err
+			}
+			if err := mergeBinaryRepoData(target, repodata); err != nil {
+				return nil, err
+			}
+			names = append(names, repodata.GetName())
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return nil, err
+		}
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode)&0755)
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(out, tr)
+		out.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return names, nil
+}
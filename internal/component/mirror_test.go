@@ -0,0 +1,131 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMirrorURL(t *testing.T) {
+	os.Unsetenv("DINGOFS_MIRROR")
+	rootDir := t.TempDir()
+	assert.Equal(t, Mirror_URL, GetMirrorURL(rootDir))
+
+	require.NoError(t, SetMirrorURL(rootDir, "file:///data/mirror"))
+	assert.Equal(t, "file:///data/mirror", GetMirrorURL(rootDir))
+
+	t.Setenv("DINGOFS_MIRROR", "http://10.0.0.1:8080")
+	assert.Equal(t, "http://10.0.0.1:8080", GetMirrorURL(rootDir))
+}
+
+// create a mirror with one tag and main branch of each component
+func newLocalMirror(t *testing.T) string {
+	mirror := t.TempDir()
+	for _, name := range ALL_COMPONENTS {
+		repodata := &BinaryRepoData{
+			Binary:   name,
+			Branches: map[string]BinaryDetail{},
+			Tags:     map[string]BinaryDetail{},
+		}
+		for _, version := range []string{"v1.0.0", MAIN_VERSION} {
+			path := filepath.Join("/", version, name)
+			data := []byte(name + version)
+			require.NoError(t, os.MkdirAll(filepath.Join(mirror, version), 0755))
+			require.NoError(t, os.WriteFile(filepath.Join(mirror, path), data, 0755))
+			detail := BinaryDetail{Path: path, BuildTime: "2026-01-01", Sha256: sha256Hex(data)}
+			if version == MAIN_VERSION {
+				repodata.Branches[version] = detail
+			} else {
+				repodata.Tags[version] = detail
+			}
+		}
+		require.NoError(t, writeBinaryRepoData(filepath.Join(mirror, versionFilename(name)), repodata))
+	}
+	return mirror
+}
+
+func TestMirror_PullAndImport(t *testing.T) {
+	mirror := newLocalMirror(t)
+	cm := &ComponentManager{rootDir: t.TempDir(), mirror: "file://" + mirror, repodata: map[string]*BinaryRepoData{}}
+	for _, name := range ALL_COMPONENTS {
+		repodata, err := NewBinaryRepoData(cm.mirror, name)
+		require.NoError(t, err)
+		cm.repodata[name] = repodata
+	}
+
+	// pull latest of dingo-client
+	dir := t.TempDir()
+	pulled, err := cm.PullComponents(dir, []string{DINGO_CLIENT}, nil)
+	require.NoError(t, err)
+	require.Len(t, pulled, 1)
+	assert.Equal(t, "v1.0.0", pulled[0].Version)
+	for _, name := range ALL_COMPONENTS {
+		assert.FileExists(t, filepath.Join(dir, versionFilename(name)))
+	}
+	assert.FileExists(t, filepath.Join(dir, "v1.0.0", DINGO_CLIENT))
+	assert.NoFileExists(t, filepath.Join(dir, "v1.0.0", DINGO_MDS))
+
+	bundle := filepath.Join(t.TempDir(), "bundle.tar")
+	require.NoError(t, PackBundle(dir, bundle))
+
+	// import into an empty mirror
+	imported := t.TempDir()
+	names, err := ImportBundle(bundle, imported)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ALL_COMPONENTS, names)
+	repodata, err := ParseFromURL("file://" + filepath.Join(imported, versionFilename(DINGO_CLIENT)))
+	require.NoError(t, err)
+	_, ok := repodata.FindVersion("v1.0.0")
+	assert.True(t, ok)
+	data, err := os.ReadFile(filepath.Join(imported, "v1.0.0", DINGO_CLIENT))
+	require.NoError(t, err)
+	assert.Equal(t, DINGO_CLIENT+"v1.0.0", string(data))
+
+	// import main of dingo-mds, the versions imported before are kept
+	dir = t.TempDir()
+	_, err = cm.PullComponents(dir, []string{DINGO_MDS}, []string{MAIN_VERSION})
+	require.NoError(t, err)
+	require.NoError(t, PackBundle(dir, bundle))
+	_, err = ImportBundle(bundle, imported)
+	require.NoError(t, err)
+
+	repodata, err = ParseFromFile(filepath.Join(imported, versionFilename(DINGO_CLIENT)))
+	require.NoError(t, err)
+	_, ok = repodata.FindVersion("v1.0.0")
+	assert.True(t, ok)
+	repodata, err = ParseFromFile(filepath.Join(imported, versionFilename(DINGO_MDS)))
+	require.NoError(t, err)
+	_, ok = repodata.GetMain()
+	assert.True(t, ok)
+
+	// unknown version
+	_, err = cm.PullComponents(t.TempDir(), []string{DINGO_CLIENT}, []string{"v9.9.9"})
+	assert.Error(t, err)
+}
+
+func TestImportBundle_Invalid(t *testing.T) {
+	_, err := ImportBundle(filepath.Join(t.TempDir(), "none.tar"), t.TempDir())
+	assert.Error(t, err)
+
+	bundle := filepath.Join(t.TempDir(), "bundle.tar")
+	require.NoError(t, os.WriteFile(bundle, []byte("not a tarball"), 0644))
+	_, err = ImportBundle(bundle, t.TempDir())
+	assert.Error(t, err)
+}
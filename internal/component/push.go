@@ -0,0 +1,92 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+)
+
+// the installed build to push, its binary is verified against the recorded sha256
+func (cm *ComponentManager) FindPushComponent(name, version string) (*Component, error) {
+	var comp *Component
+	var err error
+	if len(version) == 0 || version == LASTEST_VERSION {
+		comp, err = cm.GetActiveComponent(name)
+	} else {
+		comp, err = cm.FindInstallComponent(name, version)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("component %s:%s not installed", name, version)
+	}
+
+	filename := filepath.Join(comp.Path, comp.Name)
+	if len(comp.Sha256) == 0 {
+		// installed before digest was recorded
+		digest, err := FileSha256(filename)
+		if err != nil {
+			return nil, err
+		}
+		pushed := *comp
+		pushed.Sha256 = digest
+		return &pushed, nil
+	}
+
+	if _, err := VerifyDigest(filename, comp.Sha256); err != nil {
+		return nil, fmt.Errorf("verify %s:%s failed: %w", comp.Name, comp.Version, err)
+	}
+	return comp, nil
+}
+
+// add the pushed build into installed.json of remote host and set it as default,
+// returns the build on remote host and the new content of installed.json
+func MergePushedComponent(installed []byte, comp *Component, rootDir string) (*Component, []byte, error) {
+	remote := &ComponentManager{rootDir: rootDir}
+	if len(bytes.TrimSpace(installed)) > 0 {
+		if err := json.Unmarshal(installed, &remote.installed); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal components: %w", err)
+		}
+	}
+
+	var target *Component
+	for _, c := range remote.installed {
+		if c.Name == comp.Name && c.Version == comp.Version && c.Release == comp.Release {
+			target = c
+			break
+		}
+	}
+	if target == nil {
+		target = &Component{
+			Name:        comp.Name,
+			Version:     comp.Version,
+			Commit:      comp.Commit,
+			IsInstalled: true,
+			Release:     comp.Release,
+			Path:        filepath.Join(rootDir, comp.Name, comp.Version, buildDirName(comp.Release)),
+			URL:         comp.URL,
+		}
+		remote.installed = append(remote.installed, target)
+	}
+	target.Sha256 = comp.Sha256
+	remote.activate(target)
+
+	data, err := json.MarshalIndent(remote.installed, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal components: %w", err)
+	}
+	return target, data, nil
+}
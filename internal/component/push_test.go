@@ -0,0 +1,80 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponentManager_FindPushComponent(t *testing.T) {
+	cm := newHistoryComponentManager(t)
+
+	comp, err := cm.FindPushComponent(DINGO_CLIENT, "")
+	require.NoError(t, err)
+	assert.Equal(t, "20250301000000", comp.Release)
+	digest, err := FileSha256(filepath.Join(comp.Path, comp.Name))
+	require.NoError(t, err)
+	assert.Equal(t, digest, comp.Sha256)
+
+	comp, err = cm.FindPushComponent(DINGO_CLIENT, "v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0", comp.Version)
+
+	// the binary was modified after installed
+	installed, err := cm.FindInstallComponent(DINGO_CLIENT, "v1.0.0")
+	require.NoError(t, err)
+	installed.Sha256 = digest
+	_, err = cm.FindPushComponent(DINGO_CLIENT, "v1.0.0")
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+
+	_, err = cm.FindPushComponent(DINGO_CLIENT, "v9.9.9")
+	assert.Error(t, err)
+}
+
+func TestMergePushedComponent(t *testing.T) {
+	comp := &Component{Name: DINGO_CLIENT, Version: "v1.1.0", Release: "2025-03-01 00:00:00", Sha256: "abc"}
+
+	// nothing installed on remote host
+	target, data, err := MergePushedComponent([]byte("\n"), comp, "/home/dingo/.dingo/components")
+	require.NoError(t, err)
+	assert.Equal(t, "/home/dingo/.dingo/components/dingo-client/v1.1.0/20250301000000", target.Path)
+	assert.True(t, target.IsActive)
+
+	var installed []*Component
+	require.NoError(t, json.Unmarshal(data, &installed))
+	require.Len(t, installed, 1)
+	assert.Equal(t, "abc", installed[0].Sha256)
+
+	// the old build is deactivated, pushing the same build again adds nothing
+	old, err := json.Marshal([]*Component{{Name: DINGO_CLIENT, Version: "v1.0.0", IsActive: true}})
+	require.NoError(t, err)
+	_, data, err = MergePushedComponent(old, comp, "/root/.dingo/components")
+	require.NoError(t, err)
+	_, data, err = MergePushedComponent(data, comp, "/root/.dingo/components")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &installed))
+	require.Len(t, installed, 2)
+	assert.False(t, installed[0].IsActive)
+	assert.True(t, installed[1].IsActive)
+	assert.Len(t, installed[1].Activations, 2)
+
+	_, _, err = MergePushedComponent([]byte("{"), comp, "/root/.dingo/components")
+	assert.Error(t, err)
+}
@@ -29,6 +29,17 @@ type BinaryDetail struct {
 	BuildTime string `json:"build_time"`
 	Size      string `json:"size"`
 	Commit    string `json:"commit,omitempty"`
+	Sha256    string `json:"sha256,omitempty"`
+	Signature string `json:"signature,omitempty"` // path of detached signature
+
+	// config templates shipped with the binary, keyed by file name, e.g. mds.template.conf
+	Confs map[string]ConfDetail `json:"confs,omitempty"`
+}
+
+type ConfDetail struct {
+	Path      string `json:"path"`
+	Sha256    string `json:"sha256,omitempty"`
+	Signature string `json:"signature,omitempty"`
 }
 
 func (b *BinaryRepoData) GetBranches() map[string]BinaryDetail {
@@ -42,17 +53,45 @@ func (b *BinaryRepoData) GetCommits() map[string]BinaryDetail {
 	return b.Commits
 }
 
+// the latest stable version by semantic versioning, prerelease versions are skipped
 func (b *BinaryRepoData) GetLatest() (string, *BinaryDetail, bool) {
-	latest := "v0.0.0"
-	for version := range b.Tags {
-		if version > latest {
-			latest = version
+	var latest *Version
+	latestTag := ""
+	for tag := range b.Tags {
+		version, err := ParseVersion(tag)
+		if err != nil || len(version.Prerelease) > 0 {
+			continue
+		}
+		if latest == nil || version.Compare(latest) > 0 {
+			latest, latestTag = version, tag
+		}
+	}
+
+	tag, ok := b.Tags[latestTag]
+	if ok {
+		return latestTag, &tag, true
+	}
+
+	return "", nil, false
+}
+
+// the highest version which satisfies the constraint
+func (b *BinaryRepoData) FindConstraint(constraint *Constraint) (string, *BinaryDetail, bool) {
+	var found *Version
+	foundTag := ""
+	for tag := range b.Tags {
+		version, err := ParseVersion(tag)
+		if err != nil || !constraint.Check(version) {
+			continue
+		}
+		if found == nil || version.Compare(found) > 0 {
+			found, foundTag = version, tag
 		}
 	}
 
-	tag, ok := b.Tags[latest]
+	tag, ok := b.Tags[foundTag]
 	if ok {
-		return latest, &tag, true
+		return foundTag, &tag, true
 	}
 
 	return "", nil, false
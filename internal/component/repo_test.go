@@ -95,9 +95,17 @@ func TestBinaryRepoData_GetLatest(t *testing.T) {
 				"v1.0.0":       {Path: "/path/to/v1.0.0"},
 				"v1.0.0-beta":  {Path: "/path/to/v1.0.0-beta"},
 			},
-			expectedTag:   "v1.0.0-beta", // String comparison, v1.0.0-beta > v1.0.0-alpha > v1.0.0
+			expectedTag:   "v1.0.0", // release is greater than pre-release
 			expectedFound: true,
 		},
+		{
+			name: "only pre-release",
+			tags: map[string]BinaryDetail{
+				"v1.0.0-alpha": {Path: "/path/to/v1.0.0-alpha"},
+			},
+			expectedTag:   "",
+			expectedFound: false,
+		},
 		{
 			name: "single version",
 			tags: map[string]BinaryDetail{
@@ -123,11 +131,21 @@ func TestBinaryRepoData_GetLatest(t *testing.T) {
 			tags: map[string]BinaryDetail{
 				"v0.9.9":  {Path: "/path/to/v0.9.9"},
 				"v1.0.0":  {Path: "/path/to/v1.0.0"},
-				"v10.0.0": {Path: "/path/to/v10.0.0"}, // v10.0.0 > v1.0.0 in string comparison
+				"v10.0.0": {Path: "/path/to/v10.0.0"},
 			},
 			expectedTag:   "v10.0.0",
 			expectedFound: true,
 		},
+		{
+			name: "minor version with more digits",
+			tags: map[string]BinaryDetail{
+				"v0.9.0":  {Path: "/path/to/v0.9.0"},
+				"v0.10.0": {Path: "/path/to/v0.10.0"},
+				"invalid": {Path: "/path/to/invalid"},
+			},
+			expectedTag:   "v0.10.0",
+			expectedFound: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,295 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version, e.g. v1.2.3 or v1.2.3-rc1
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease string
+	// number of specified parts, e.g. 2 for "1.2"
+	parts int
+}
+
+type comparator struct {
+	op      string
+	version *Version
+}
+
+// Constraint is a version range, e.g. "~1.2", "^1.0" or ">=1.3 <2",
+// comparators separated by space or comma are ANDed, "||" ORs the ranges
+type Constraint struct {
+	raw    string
+	ranges [][]comparator
+}
+
+func ParseVersion(s string) (*Version, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.Index(v, "+"); i >= 0 { // build metadata is ignored
+		v = v[:i]
+	}
+
+	version := &Version{}
+	if i := strings.Index(v, "-"); i >= 0 {
+		version.Prerelease = v[i+1:]
+		v = v[:i]
+		if len(version.Prerelease) == 0 {
+			return nil, fmt.Errorf("invalid version %s", s)
+		}
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 || len(v) == 0 {
+		return nil, fmt.Errorf("invalid version %s", s)
+	}
+	numbers := []*int{&version.Major, &version.Minor, &version.Patch}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid version %s", s)
+		}
+		*numbers[i] = n
+	}
+	version.parts = len(parts)
+	return version, nil
+}
+
+func (v *Version) String() string {
+	s := fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) > 0 {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+func compareInt(a, b int) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+// compare the prerelease identifiers by semver rules, release is greater than prerelease
+func comparePrerelease(a, b string) int {
+	if a == b {
+		return 0
+	} else if len(a) == 0 {
+		return 1
+	} else if len(b) == 0 {
+		return -1
+	}
+
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aerr := strconv.Atoi(as[i])
+		bn, berr := strconv.Atoi(bs[i])
+		switch {
+		case aerr == nil && berr == nil:
+			if c := compareInt(an, bn); c != 0 {
+				return c
+			}
+		case aerr == nil: // numeric identifiers have lower precedence
+			return -1
+		case berr == nil:
+			return 1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareInt(len(as), len(bs))
+}
+
+func (v *Version) Compare(o *Version) int {
+	if c := compareInt(v.Major, o.Major); c != 0 {
+		return c
+	}
+	if c := compareInt(v.Minor, o.Minor); c != 0 {
+		return c
+	}
+	if c := compareInt(v.Patch, o.Patch); c != 0 {
+		return c
+	}
+	return comparePrerelease(v.Prerelease, o.Prerelease)
+}
+
+// CompareVersion compares two version strings, the invalid one is less than the valid one
+func CompareVersion(a, b string) int {
+	va, erra := ParseVersion(a)
+	vb, errb := ParseVersion(b)
+	switch {
+	case erra != nil && errb != nil:
+		return strings.Compare(a, b)
+	case erra != nil:
+		return -1
+	case errb != nil:
+		return 1
+	}
+	return va.Compare(vb)
+}
+
+// IsConstraint returns whether the input is a version range rather than a exact tag
+func IsConstraint(s string) bool {
+	if strings.ContainsAny(s, "~^<>=*xX |,") {
+		return true
+	}
+	v, err := ParseVersion(s)
+	return err == nil && v.parts < 3
+}
+
+func ParseConstraint(s string) (*Constraint, error) {
+	constraint := &Constraint{raw: s}
+	for _, r := range strings.Split(s, "||") {
+		comparators := []comparator{}
+		fields := strings.FieldsFunc(r, func(c rune) bool { return c == ' ' || c == ',' })
+		for _, field := range fields {
+			cs, err := parseComparator(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version constraint %s: %w", s, err)
+			}
+			comparators = append(comparators, cs...)
+		}
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("invalid version constraint %s", s)
+		}
+		constraint.ranges = append(constraint.ranges, comparators)
+	}
+	return constraint, nil
+}
+
+// expand the operator into primitive comparators, e.g. ~1.2 => >=1.2.0 <1.3.0
+func parseComparator(s string) ([]comparator, error) {
+	op := ""
+	for _, prefix := range []string{">=", "<=", "!=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(s, prefix) {
+			op, s = prefix, strings.TrimSpace(s[len(prefix):])
+			break
+		}
+	}
+
+	// wildcard, e.g. "*", "1.x" or "1.2.*"
+	if s == "*" || s == "x" || s == "X" {
+		return []comparator{}, nil
+	}
+	for _, suffix := range []string{".*", ".x", ".X"} {
+		for strings.HasSuffix(s, suffix) {
+			s = strings.TrimSuffix(s, suffix)
+		}
+	}
+
+	v, err := ParseVersion(s)
+	if err != nil {
+		return nil, err
+	}
+	upper := func(parts int) *Version {
+		switch parts {
+		case 1:
+			return &Version{Major: v.Major + 1, Prerelease: "0"}
+		case 2:
+			return &Version{Major: v.Major, Minor: v.Minor + 1, Prerelease: "0"}
+		}
+		return &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1, Prerelease: "0"}
+	}
+
+	switch op {
+	case "~":
+		// ~1 := >=1.0.0 <2.0.0, ~1.2 and ~1.2.3 := <1.3.0
+		return []comparator{{">=", v}, {"<", upper(min(v.parts, 2))}}, nil
+	case "^":
+		// ^1.2.3 := <2.0.0, ^0.2.3 := <0.3.0, ^0.0.3 := <0.0.4
+		parts := 1
+		if v.Major == 0 && v.parts > 1 {
+			parts = 2
+			if v.Minor == 0 && v.parts > 2 {
+				parts = 3
+			}
+		}
+		return []comparator{{">=", v}, {"<", upper(parts)}}, nil
+	case "", "=":
+		if v.parts < 3 { // partial version, e.g. "1.2" := >=1.2.0 <1.3.0
+			return []comparator{{">=", v}, {"<", upper(v.parts)}}, nil
+		}
+		return []comparator{{"=", v}}, nil
+	case ">":
+		if v.parts < 3 { // >1.2 := >=1.3.0
+			return []comparator{{">=", upper(v.parts)}}, nil
+		}
+	case "<=":
+		if v.parts < 3 { // <=1.2 := <1.3.0
+			return []comparator{{"<", upper(v.parts)}}, nil
+		}
+	}
+	return []comparator{{op, v}}, nil
+}
+
+func (c comparator) check(v *Version) bool {
+	n := v.Compare(c.version)
+	switch c.op {
+	case ">=":
+		return n >= 0
+	case "<=":
+		return n <= 0
+	case ">":
+		return n > 0
+	case "<":
+		return n < 0
+	case "!=":
+		return n != 0
+	}
+	return n == 0
+}
+
+// Check returns whether the version satisfies the constraint, the prerelease
+// version only matches when the constraint has a prerelease on the same version
+func (c *Constraint) Check(v *Version) bool {
+	for _, comparators := range c.ranges {
+		matched := true
+		for _, cmp := range comparators {
+			if !cmp.check(v) {
+				matched = false
+				break
+			}
+		}
+		if matched && (len(v.Prerelease) == 0 || allowPrerelease(comparators, v)) {
+			return true
+		}
+	}
+	return false
+}
+
+func allowPrerelease(comparators []comparator, v *Version) bool {
+	for _, cmp := range comparators {
+		cv := cmp.version
+		if len(cv.Prerelease) > 0 && cv.Prerelease != "0" &&
+			cv.Major == v.Major && cv.Minor == v.Minor && cv.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Constraint) String() string {
+	return c.raw
+}
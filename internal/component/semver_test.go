@@ -0,0 +1,149 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	v, err := ParseVersion("v1.2.3-rc.1+build5")
+	require.NoError(t, err)
+	assert.Equal(t, &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", parts: 3}, v)
+	assert.Equal(t, "v1.2.3-rc.1", v.String())
+
+	v, err = ParseVersion("1.2")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.0", v.String())
+
+	for _, s := range []string{"", "v", "main", "v1.2.3.4", "v1.x", "v1.2.3-"} {
+		_, err := ParseVersion(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestCompareVersion(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"v0.10.0", "v0.9.0", 1},
+		{"v1.0.0", "v1.0.0", 0},
+		{"v1.0.0", "1.0", 0},
+		{"v1.0.0-alpha", "v1.0.0", -1},
+		{"v1.0.0-alpha", "v1.0.0-alpha.1", -1},
+		{"v1.0.0-alpha.1", "v1.0.0-alpha.beta", -1},
+		{"v1.0.0-beta.2", "v1.0.0-beta.11", -1},
+		{"v1.0.0-rc.1", "v1.0.0-beta.11", 1},
+		{"main", "v1.0.0", -1},
+		{"v1.0.0", "main", 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, CompareVersion(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+}
+
+func TestIsConstraint(t *testing.T) {
+	for _, s := range []string{"~1.2", "^1.0", ">=1.3 <2", "1.2", "1", "1.x", "*", "<2,>1"} {
+		assert.True(t, IsConstraint(s), s)
+	}
+	for _, s := range []string{"v1.2.3", "1.2.3", "main", "latest"} {
+		assert.False(t, IsConstraint(s), s)
+	}
+}
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		matched    []string
+		unmatched  []string
+	}{
+		{"~1.2", []string{"v1.2.0", "v1.2.9"}, []string{"v1.3.0", "v1.1.9", "v1.2.5-rc1"}},
+		{"~1.2.3", []string{"v1.2.3", "v1.2.9"}, []string{"v1.2.2", "v1.3.0"}},
+		{"~1", []string{"v1.0.0", "v1.9.9"}, []string{"v2.0.0"}},
+		{"^1.0", []string{"v1.0.0", "v1.9.0"}, []string{"v2.0.0", "v0.9.0"}},
+		{"^0.2.3", []string{"v0.2.3", "v0.2.9"}, []string{"v0.3.0", "v0.2.2"}},
+		{"^0.0.3", []string{"v0.0.3"}, []string{"v0.0.4"}},
+		{">=1.3 <2", []string{"v1.3.0", "v1.10.0"}, []string{"v1.2.9", "v2.0.0", "v2.0.0-rc1"}},
+		{">=1.3, <2", []string{"v1.3.0"}, []string{"v2.0.0"}},
+		{">1.2", []string{"v1.3.0"}, []string{"v1.2.9"}},
+		{"<=1.2", []string{"v1.2.9"}, []string{"v1.3.0"}},
+		{"1.2", []string{"v1.2.0", "v1.2.7"}, []string{"v1.3.0"}},
+		{"1.2.x", []string{"v1.2.7"}, []string{"v1.3.0"}},
+		{"=1.2.3", []string{"v1.2.3"}, []string{"v1.2.4"}},
+		{"!=1.2.3", []string{"v1.2.4"}, []string{"v1.2.3"}},
+		{"*", []string{"v0.1.0", "v9.0.0"}, []string{"v1.0.0-rc1"}},
+		{"~1.2 || ^3.0", []string{"v1.2.1", "v3.5.0"}, []string{"v2.0.0"}},
+		{">=1.2.3-rc1", []string{"v1.2.3-rc2", "v1.2.3"}, []string{"v1.2.4-rc1", "v1.2.3-rc0"}},
+	}
+	for _, tt := range tests {
+		constraint, err := ParseConstraint(tt.constraint)
+		require.NoError(t, err, tt.constraint)
+		for _, s := range tt.matched {
+			v, _ := ParseVersion(s)
+			assert.True(t, constraint.Check(v), "%s should match %s", s, tt.constraint)
+		}
+		for _, s := range tt.unmatched {
+			v, _ := ParseVersion(s)
+			assert.False(t, constraint.Check(v), "%s should not match %s", s, tt.constraint)
+		}
+	}
+
+	for _, s := range []string{"", "~", ">=abc", "1.2 ||", "~1.2.3.4"} {
+		_, err := ParseConstraint(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestComponentManager_FindVersionWithConstraint(t *testing.T) {
+	cm := &ComponentManager{
+		repodata: map[string]*BinaryRepoData{
+			DINGO_CLIENT: {
+				Tags: map[string]BinaryDetail{
+					"v1.2.0":     {Path: "/tags/v1.2.0"},
+					"v1.2.10":    {Path: "/tags/v1.2.10"},
+					"v1.2.9":     {Path: "/tags/v1.2.9"},
+					"v1.3.0-rc1": {Path: "/tags/v1.3.0-rc1"},
+					"v2.0.0":     {Path: "/tags/v2.0.0"},
+				},
+			},
+		},
+	}
+
+	version, detail, err := cm.FindVersion(DINGO_CLIENT, "~1.2")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.10", version)
+	assert.Equal(t, "/tags/v1.2.10", detail.Path)
+
+	version, _, err = cm.FindVersion(DINGO_CLIENT, ">=1.2.5 <2")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.10", version)
+
+	version, _, err = cm.FindVersion(DINGO_CLIENT, LASTEST_VERSION)
+	require.NoError(t, err)
+	assert.Equal(t, "v2.0.0", version)
+
+	version, _, err = cm.FindVersion(DINGO_CLIENT, "v1.3.0-rc1")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.3.0-rc1", version)
+
+	_, _, err = cm.FindVersion(DINGO_CLIENT, "^3.0")
+	assert.Error(t, err)
+	_, _, err = cm.FindVersion(DINGO_CLIENT, "v9.9.9")
+	assert.Error(t, err)
+}
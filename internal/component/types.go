@@ -49,13 +49,15 @@ var ALL_COMPONENTS = []string{
 }
 
 type Component struct {
-	Name        string `json:"name"`
-	Version     string `json:"version"`
-	Commit      string `json:"commit"`
-	IsInstalled bool   `json:"installed"`
-	IsActive    bool   `json:"active"`
-	Release     string `json:"release"`
-	Path        string `json:"path"`
-	URL         string `json:"url"`
-	Updatable   bool   `json:"-"`
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	Commit      string   `json:"commit"`
+	IsInstalled bool     `json:"installed"`
+	IsActive    bool     `json:"active"`
+	Release     string   `json:"release"`
+	Path        string   `json:"path"`
+	URL         string   `json:"url"`
+	Sha256      string   `json:"sha256,omitempty"`
+	Activations []string `json:"activations,omitempty"` // time of each activation
+	Updatable   bool     `json:"-"`
 }
@@ -9,15 +9,17 @@ import (
 	"os"
 	"path"
 	"strings"
+
+	"github.com/dingodb/dingocli/internal/utils"
 )
 
 // input string maybe:
 // dingo-mds:v1.0.0
+// dingo-mds@~1.2
 // dingo-client
 func ParseComponentVersion(input string) (string, string) {
-	if strings.Contains(input, ":") {
-		parts := strings.SplitN(input, ":", 2)
-		return parts[0], parts[1]
+	if i := strings.IndexAny(input, ":@"); i >= 0 {
+		return input[:i], input[i+1:]
 	}
 
 	return input, ""
@@ -57,6 +59,11 @@ func ParseFromFile(filename string) (*BinaryRepoData, error) {
 }
 
 func ParseFromURL(url string) (*BinaryRepoData, error) {
+	// local mirror, e.g. file:///data/mirror/dingo-client.version
+	if filename, ok := utils.LocalSourcePath(url); ok {
+		return ParseFromFile(filename)
+	}
+
 	resp, err := http.Get(url)
 	if err != nil {
 		return nil, err
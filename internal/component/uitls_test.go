@@ -38,6 +38,12 @@ func TestParseComponentVersion(t *testing.T) {
 			expectedName: "dingo-mds",
 			expectedVer:  "v1.0.0",
 		},
+		{
+			name:         "with constraint",
+			input:        "dingo-mds@>=1.3 <2",
+			expectedName: "dingo-mds",
+			expectedVer:  ">=1.3 <2",
+		},
 		{
 			name:         "without version",
 			input:        "dingo-client",
@@ -116,6 +122,18 @@ func TestURLJoin(t *testing.T) {
 			paths:    []string{"components"},
 			expected: "https://example.com/v1/components",
 		},
+		{
+			name:     "file mirror",
+			base:     "file:///data/mirror",
+			paths:    []string{"/tags/v1.0.0/dingo-mds"},
+			expected: "file:///data/mirror/tags/v1.0.0/dingo-mds",
+		},
+		{
+			name:     "local path mirror",
+			base:     "/data/mirror",
+			paths:    []string{"dingo-mds.version"},
+			expected: "/data/mirror/dingo-mds.version",
+		},
 	}
 
 	for _, tt := range tests {
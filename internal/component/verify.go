@@ -0,0 +1,200 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+const (
+	TRUSTED_KEY_FILE = "trusted.pub"
+
+	VERIFY_STATUS_OK       = "ok"
+	VERIFY_STATUS_MISMATCH = "mismatch"
+	VERIFY_STATUS_MISSING  = "missing"
+	VERIFY_STATUS_UNKNOWN  = "unknown"
+)
+
+var (
+	ErrDigestMismatch    = errors.New("sha256 digest mismatch")
+	ErrSignatureMismatch = errors.New("signature verification failed")
+	ErrUnverified        = errors.New("trusted public key configured but binary is unverifiable")
+)
+
+type VerifyResult struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Path     string `json:"path"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+	Status   string `json:"status"`
+}
+
+func FileSha256(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func VerifyDigest(filename, expected string) (string, error) {
+	actual, err := FileSha256(filename)
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(actual, expected) {
+		return actual, fmt.Errorf("%w: expected %s, got %s", ErrDigestMismatch, expected, actual)
+	}
+	return actual, nil
+}
+
+// the detached signature is base64 encoded ed25519 signature of the sha256 digest
+func VerifySignature(publicKey ed25519.PublicKey, digest string, signature string) error {
+	sum, err := hex.DecodeString(digest)
+	if err != nil {
+		return fmt.Errorf("invalid sha256 digest %s: %w", digest, err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if !ed25519.Verify(publicKey, sum, sig) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
+
+// the trusted public key is base64 encoded ed25519 public key,
+// read from env DINGOFS_MIRROR_PUBLIC_KEY or ~/.dingo/components/trusted.pub
+func LoadTrustedKey(rootDir string) (ed25519.PublicKey, error) {
+	data, ok := os.LookupEnv("DINGOFS_MIRROR_PUBLIC_KEY")
+	if !ok {
+		content, err := os.ReadFile(filepath.Join(rootDir, TRUSTED_KEY_FILE))
+		if os.IsNotExist(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		data = string(content)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted public key: %w", err)
+	} else if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid trusted public key: bad key size %d", len(key))
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// verify the downloaded binary with the digest and signature in repo metadata
+func (cm *ComponentManager) verifyDownload(filename string, binaryDetail *BinaryDetail) (string, error) {
+	digest, err := FileSha256(filename)
+	if err != nil {
+		return "", err
+	}
+	if err := cm.verifyRepoDigest(binaryDetail.Path, digest, binaryDetail.Sha256, binaryDetail.Signature); err != nil {
+		return "", err
+	}
+	return digest, nil
+}
+
+// once a trusted public key is configured, both the digest and signature are
+// required, otherwise they are checked only if present in repo metadata
+func (cm *ComponentManager) verifyRepoDigest(path, digest, expected, signaturePath string) error {
+	publicKey, err := LoadTrustedKey(cm.rootDir)
+	if err != nil {
+		return err
+	} else if publicKey == nil {
+		if len(expected) == 0 {
+			fmt.Printf("Warning: no sha256 digest for %s in repository, skip verification\n", path)
+			return nil
+		} else if !strings.EqualFold(digest, expected) {
+			return fmt.Errorf("%w: expected %s, got %s", ErrDigestMismatch, expected, digest)
+		} else if len(signaturePath) > 0 {
+			fmt.Printf("Warning: no trusted public key found, skip signature verification of %s\n", path)
+		}
+		return nil
+	}
+
+	if len(expected) == 0 {
+		return fmt.Errorf("%w: no sha256 digest for %s in repository", ErrUnverified, path)
+	} else if len(signaturePath) == 0 {
+		return fmt.Errorf("%w: no signature for %s in repository", ErrUnverified, path)
+	} else if !strings.EqualFold(digest, expected) {
+		return fmt.Errorf("%w: expected %s, got %s", ErrDigestMismatch, expected, digest)
+	}
+
+	signature, err := utils.GetRemoteFileContent(URLJoin(cm.mirror, signaturePath))
+	if err != nil {
+		return fmt.Errorf("failed to download signature: %w", err)
+	}
+	return VerifySignature(publicKey, digest, signature)
+}
+
+// recheck the installed binaries against the digest saved in installed.json
+func (cm *ComponentManager) VerifyComponents(names ...string) ([]*VerifyResult, error) {
+	results := []*VerifyResult{}
+	for _, comp := range cm.installed {
+		if len(names) > 0 && !utils.Contains(names, comp.Name) {
+			continue
+		}
+
+		result := &VerifyResult{
+			Name:     comp.Name,
+			Version:  comp.Version,
+			Path:     filepath.Join(comp.Path, comp.Name),
+			Expected: comp.Sha256,
+		}
+		actual, err := FileSha256(result.Path)
+		switch {
+		case err != nil:
+			result.Status = VERIFY_STATUS_MISSING
+		case len(comp.Sha256) == 0:
+			result.Actual = actual
+			result.Status = VERIFY_STATUS_UNKNOWN
+		case !strings.EqualFold(actual, comp.Sha256):
+			result.Actual = actual
+			result.Status = VERIFY_STATUS_MISMATCH
+		default:
+			result.Actual = actual
+			result.Status = VERIFY_STATUS_OK
+		}
+		results = append(results, result)
+	}
+
+	if len(names) > 0 && len(results) == 0 {
+		return nil, fmt.Errorf("component %s not installed", strings.Join(names, ","))
+	}
+	return results, nil
+}
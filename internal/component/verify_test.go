@@ -0,0 +1,178 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestVerifyDigest(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "dingo-client")
+	require.NoError(t, os.WriteFile(filename, []byte("binary"), 0755))
+
+	digest, err := FileSha256(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, sha256Hex([]byte("binary")), digest)
+
+	_, err = VerifyDigest(filename, digest)
+	assert.NoError(t, err)
+
+	_, err = VerifyDigest(filename, sha256Hex([]byte("other")))
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+
+	_, err = FileSha256(filename + ".none")
+	assert.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	digest := sha256Hex([]byte("binary"))
+	sum, _ := hex.DecodeString(digest)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, sum))
+
+	assert.NoError(t, VerifySignature(publicKey, digest, signature+"\n"))
+	assert.ErrorIs(t, VerifySignature(publicKey, sha256Hex([]byte("other")), signature), ErrSignatureMismatch)
+	assert.Error(t, VerifySignature(publicKey, digest, "not-base64!"))
+	assert.Error(t, VerifySignature(publicKey, "zz", signature))
+}
+
+func TestLoadTrustedKey(t *testing.T) {
+	os.Unsetenv("DINGOFS_MIRROR_PUBLIC_KEY")
+	rootDir := t.TempDir()
+
+	key, err := LoadTrustedKey(rootDir)
+	assert.NoError(t, err)
+	assert.Nil(t, key)
+
+	publicKey, _, _ := ed25519.GenerateKey(rand.Reader)
+	encoded := base64.StdEncoding.EncodeToString(publicKey)
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, TRUSTED_KEY_FILE), []byte(encoded+"\n"), 0644))
+	key, err = LoadTrustedKey(rootDir)
+	assert.NoError(t, err)
+	assert.Equal(t, publicKey, key)
+
+	t.Setenv("DINGOFS_MIRROR_PUBLIC_KEY", base64.StdEncoding.EncodeToString([]byte("short")))
+	_, err = LoadTrustedKey(rootDir)
+	assert.Error(t, err)
+}
+
+func TestComponentManager_verifyDownload(t *testing.T) {
+	os.Unsetenv("DINGOFS_MIRROR_PUBLIC_KEY")
+	rootDir := t.TempDir()
+	publicKey, privateKey, _ := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, TRUSTED_KEY_FILE),
+		[]byte(base64.StdEncoding.EncodeToString(publicKey)), 0644))
+
+	data := []byte("binary")
+	digest := sha256Hex(data)
+	sum, _ := hex.DecodeString(digest)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, sum))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/dingo-client.sig" {
+			w.Write([]byte(signature))
+			return
+		}
+		w.Write([]byte(base64.StdEncoding.EncodeToString(make([]byte, ed25519.SignatureSize))))
+	}))
+	defer server.Close()
+
+	filename := filepath.Join(rootDir, "dingo-client.download")
+	require.NoError(t, os.WriteFile(filename, data, 0755))
+	cm := &ComponentManager{rootDir: rootDir, mirror: server.URL}
+
+	got, err := cm.verifyDownload(filename, &BinaryDetail{Sha256: digest, Signature: "dingo-client.sig"})
+	assert.NoError(t, err)
+	assert.Equal(t, digest, got)
+
+	_, err = cm.verifyDownload(filename, &BinaryDetail{Sha256: digest, Signature: "bad.sig"})
+	assert.ErrorIs(t, err, ErrSignatureMismatch)
+
+	_, err = cm.verifyDownload(filename, &BinaryDetail{Sha256: sha256Hex([]byte("other")), Signature: "dingo-client.sig"})
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+
+	// the mirror strips digest or signature while a trusted key is configured
+	_, err = cm.verifyDownload(filename, &BinaryDetail{})
+	assert.ErrorIs(t, err, ErrUnverified)
+	_, err = cm.verifyDownload(filename, &BinaryDetail{Sha256: digest})
+	assert.ErrorIs(t, err, ErrUnverified)
+	_, err = cm.verifyDownload(filename, &BinaryDetail{Signature: "dingo-client.sig"})
+	assert.ErrorIs(t, err, ErrUnverified)
+
+	// no trusted key, the signature is skipped and missing digest is warned only
+	require.NoError(t, os.Remove(filepath.Join(rootDir, TRUSTED_KEY_FILE)))
+	got, err = cm.verifyDownload(filename, &BinaryDetail{Sha256: digest, Signature: "bad.sig"})
+	assert.NoError(t, err)
+	assert.Equal(t, digest, got)
+	got, err = cm.verifyDownload(filename, &BinaryDetail{})
+	assert.NoError(t, err)
+	assert.Equal(t, digest, got)
+	_, err = cm.verifyDownload(filename, &BinaryDetail{Sha256: sha256Hex([]byte("other"))})
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+}
+
+func TestComponentManager_VerifyComponents(t *testing.T) {
+	rootDir := t.TempDir()
+	install := func(name, version string, data []byte) string {
+		dir := filepath.Join(rootDir, name, version)
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0755))
+		return dir
+	}
+
+	cm := &ComponentManager{
+		rootDir: rootDir,
+		installed: []*Component{
+			{Name: DINGO_CLIENT, Version: "v1.0.0", Path: install(DINGO_CLIENT, "v1.0.0", []byte("a")), Sha256: sha256Hex([]byte("a"))},
+			{Name: DINGO_MDS, Version: "v1.0.0", Path: install(DINGO_MDS, "v1.0.0", []byte("b")), Sha256: sha256Hex([]byte("a"))},
+			{Name: DINGO_DACHE, Version: "v1.0.0", Path: install(DINGO_DACHE, "v1.0.0", []byte("c"))},
+			{Name: DINGO_MDS_CLIENT, Version: "v1.0.0", Path: filepath.Join(rootDir, "none"), Sha256: sha256Hex([]byte("d"))},
+		},
+	}
+
+	results, err := cm.VerifyComponents()
+	assert.NoError(t, err)
+	require.Len(t, results, 4)
+	assert.Equal(t, VERIFY_STATUS_OK, results[0].Status)
+	assert.Equal(t, VERIFY_STATUS_MISMATCH, results[1].Status)
+	assert.Equal(t, VERIFY_STATUS_UNKNOWN, results[2].Status)
+	assert.Equal(t, VERIFY_STATUS_MISSING, results[3].Status)
+
+	results, err = cm.VerifyComponents(DINGO_MDS)
+	assert.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, DINGO_MDS, results[0].Name)
+
+	_, err = cm.VerifyComponents("dingo-none")
+	assert.Error(t, err)
+}
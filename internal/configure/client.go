@@ -19,6 +19,7 @@ package configure
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/dingodb/dingocli/internal/build"
@@ -231,6 +232,28 @@ func (cc *ClientConfig) GetContainerImage() string {
 	return containerImage
 }
 
+// e.g. "/data1/cache:10240;/data2/cache" => ["/data1/cache", "/data2/cache"]
+func (cc *ClientConfig) GetDiskCacheDirs() []string {
+	return ParseDiskCacheDirs(cc.getString(KEY_DISK_CACHE_CACHE_DIR))
+}
+
+func ParseDiskCacheDirs(value string) []string {
+	dirs := []string{}
+	for _, item := range strings.Split(value, ";") {
+		item = strings.TrimSpace(item)
+		// strip the optional capacity suffix, e.g. ":10240"
+		if idx := strings.LastIndex(item, ":"); idx > 0 {
+			if _, err := strconv.Atoi(item[idx+1:]); err == nil {
+				item = item[:idx]
+			}
+		}
+		if len(item) > 0 {
+			dirs = append(dirs, item)
+		}
+	}
+	return dirs
+}
+
 func (cc *ClientConfig) GetClusterMDSAddr(mountFSType string) string {
 	if mountFSType == FS_TYPE_VKS_V2 {
 		return cc.getString(KEY_DINGOFS_LISTEN_MDSV2_ADDRS)
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dingocli
+
+import (
+	"strings"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/viper"
+)
+
+const (
+	KEY_PLAYBOOK_CONCURRENCY = "playbook.concurrency"
+)
+
+/*
+ * playbook concurrency in dingo.yaml:
+ *
+ * playbook:
+ *   concurrency:
+ *     default: 10     # tasks executed in parallel for each step
+ *     host: 2         # tasks executed in parallel on one host
+ *     role:           # tasks executed in parallel for one service role
+ *       store: 1
+ *     step:           # tasks executed in parallel for the step, overrides default
+ *       pull_image: 5
+ *
+ * 0 or absent means no limit for host and role,
+ * the step falls back to its own concurrency, then default, then 10
+ */
+type ConcurrencyConfig struct {
+	Default uint
+	Host    uint
+	Role    map[string]uint
+	Step    map[string]uint
+}
+
+func NewConcurrencyConfig() *ConcurrencyConfig {
+	return &ConcurrencyConfig{
+		Role: map[string]uint{},
+		Step: map[string]uint{},
+	}
+}
+
+func ParseConcurrencyConfig(filename string) (*ConcurrencyConfig, error) {
+	cfg := NewConcurrencyConfig()
+	if !utils.PathExist(filename) {
+		return cfg, nil
+	}
+
+	parser := viper.New()
+	parser.SetConfigFile(filename)
+	parser.SetConfigType("yaml")
+	err := parser.ReadInConfig()
+	if err != nil {
+		return nil, errno.ERR_PARSE_DINGO_CONFIGURE_FAILED.E(err)
+	}
+
+	// decode as int for checking negative value
+	raw := struct {
+		Default int            `mapstructure:"default"`
+		Host    int            `mapstructure:"host"`
+		Role    map[string]int `mapstructure:"role"`
+		Step    map[string]int `mapstructure:"step"`
+	}{}
+	err = parser.UnmarshalKey(KEY_PLAYBOOK_CONCURRENCY, &raw)
+	if err != nil {
+		return nil, errno.ERR_PARSE_DINGO_CONFIGURE_FAILED.E(err)
+	}
+
+	items := map[string]int{"default": raw.Default, "host": raw.Host}
+	for role, n := range raw.Role {
+		items["role."+role] = n
+		cfg.Role[role] = uint(n)
+	}
+	for step, n := range raw.Step {
+		items["step."+step] = n
+		cfg.Step[StepConcurrencyKey(step)] = uint(n)
+	}
+	for key, n := range items {
+		if n < 0 {
+			return nil, errno.ERR_INVALID_CONCURRENCY.F("%s: %d", key, n)
+		}
+	}
+	cfg.Default = uint(raw.Default)
+	cfg.Host = uint(raw.Host)
+	return cfg, nil
+}
+
+// StepConcurrencyKey converts the step name to its key in config, e.g. "Pull Image" -> "pull_image"
+func StepConcurrencyKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), "_"))
+}
+
+func (cfg *ConcurrencyConfig) GetHostConcurrency() uint {
+	if cfg == nil {
+		return 0
+	}
+	return cfg.Host
+}
+
+func (cfg *ConcurrencyConfig) GetRoleConcurrency() map[string]uint {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.Role
+}
+
+func (cfg *ConcurrencyConfig) GetDefaultConcurrency() uint {
+	if cfg == nil {
+		return 0
+	}
+	return cfg.Default
+}
+
+// GetStepConcurrency returns the concurrency configured for the step, 0 means not configured
+func (cfg *ConcurrencyConfig) GetStepConcurrency(name string) uint {
+	if cfg == nil {
+		return 0
+	}
+	return cfg.Step[StepConcurrencyKey(name)]
+}
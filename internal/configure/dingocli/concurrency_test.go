@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dingocli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConcurrencyConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	filename := filepath.Join(t.TempDir(), "dingo.yaml")
+	data := `
+dingofs:
+  mdsaddr: 127.0.0.1:6700
+playbook:
+  concurrency:
+    default: 20
+    host: 2
+    role:
+      store: 1
+    step:
+      PULL_IMAGE: 5
+`
+	assert.Nil(os.WriteFile(filename, []byte(data), 0644))
+	cfg, err := ParseConcurrencyConfig(filename)
+	assert.Nil(err)
+	assert.Equal(uint(20), cfg.GetDefaultConcurrency())
+	assert.Equal(uint(2), cfg.GetHostConcurrency())
+	assert.Equal(map[string]uint{"store": 1}, cfg.GetRoleConcurrency())
+	assert.Equal(uint(5), cfg.GetStepConcurrency("Pull Image"))
+	assert.Equal(uint(0), cfg.GetStepConcurrency("Start Store"))
+
+	// not exist
+	cfg, err = ParseConcurrencyConfig(filepath.Join(t.TempDir(), "none.yaml"))
+	assert.Nil(err)
+	assert.Equal(uint(0), cfg.GetHostConcurrency())
+	assert.NotNil(cfg.Role)
+
+	// negative
+	assert.Nil(os.WriteFile(filename, []byte("playbook:\n  concurrency:\n    host: -1\n"), 0644))
+	_, err = ParseConcurrencyConfig(filename)
+	assert.NotNil(err)
+}
@@ -20,10 +20,12 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 
 	"github.com/dingodb/dingocli/internal/build"
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
 	"github.com/spf13/viper"
 )
 
@@ -138,6 +140,11 @@ func parseDefaultsSection(cfg *DingoCliConfig, defaults map[string]interface{})
 
 		// container engine
 		case KEY_ENGINE:
+			if !module.IsSupportedEngine(v.(string)) {
+				return errno.ERR_UNSUPPORT_DINGOADM_CONTAINER_ENGINE.
+					F("%s: %s (supported: %s)", KEY_ENGINE, v.(string),
+						strings.Join(module.SupportedEngines(), ", "))
+			}
 			cfg.Engine = v.(string)
 
 		// timeout
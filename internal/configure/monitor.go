@@ -264,7 +264,8 @@ func parsePrometheusTarget(dcs []*topology.DeployConfig) (string, error) {
 			item = fmt.Sprintf("%s:%d", ip, dc.GetListenClientPort())
 		case topology.ROLE_CHUNKSERVER,
 			// topology.ROLE_MDS_V1,
-			topology.ROLE_METASERVER:
+			topology.ROLE_METASERVER,
+			topology.ROLE_FS_CACHE:
 			item = fmt.Sprintf("%s:%d", ip, dc.GetListenPort())
 		case topology.ROLE_SNAPSHOTCLONE:
 			item = fmt.Sprintf("%s:%d", ip, dc.GetListenDummyPort())
@@ -42,6 +42,9 @@ const (
 	ROLE_FS_MDS     = "mds"
 	ROLE_FS_MDS_CLI = "mds-client" // tmp role: e.g. create meta tables
 
+	// dingofs cache
+	ROLE_FS_CACHE = "cache"
+
 	// dingo-store
 	ROLE_COORDINATOR      = "coordinator"
 	ROLE_STORE            = "store"
@@ -60,6 +63,10 @@ const (
 	SCRIPT_START_EXECUTOR      = "start-executor.sh"
 	SCRIPT_CREATE_MDSV2_TABLES = "create_mds_tables.sh"
 
+	// deploy mode
+	DEPLOY_MODE_CONTAINER = "container"
+	DEPLOY_MODE_SYSTEMD   = "systemd"
+
 	// ctx version
 	CTX_KEY_MDS_VERSION = "mds.version"
 	CTX_VAL_MDS_V1      = "v1"
@@ -75,6 +82,14 @@ var SKIP_ALL_ROLES = []string{
 	ROLE_DINGODB_EXECUTOR,
 }
 
+// roles which can be deployed as systemd service on bare-metal,
+// coordinator and store are not supported yet
+var SYSTEMD_ROLES = []string{
+	ROLE_FS_MDS,
+	ROLE_FS_MDS_CLI,
+	ROLE_FS_CACHE,
+}
+
 type (
 	DeployConfig struct {
 		kind              string // KIND_DINGOFS
@@ -316,6 +331,11 @@ func (dc *DeployConfig) ResolveHost() error {
 	return nil
 }
 
+// override the image of service, e.g. rollback to the image before upgrade
+func (dc *DeployConfig) SetContainerImage(image string) {
+	dc.config[CONFIG_CONTAINER_IMAGE.key] = image
+}
+
 func (dc *DeployConfig) Build() error {
 	err := dc.renderVariables()
 	if err != nil {
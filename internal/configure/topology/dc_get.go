@@ -22,6 +22,7 @@ import (
 
 	"github.com/dingodb/dingocli/internal/utils"
 	"github.com/dingodb/dingocli/pkg/variable"
+	"github.com/google/uuid"
 )
 
 const (
@@ -39,6 +40,7 @@ const (
 	LAYOUT_SERVICE_LOGS_DIR             = "/logs"
 	LAYOUT_SERVICE_LOG_DIR              = "/log"
 	LAYOUT_SERVICE_DATA_DIR             = "/data"
+	LAYOUT_SERVICE_CACHE_DIR            = "/cache"
 	LAYOUT_FS_TOOLS_DIR                 = "/tools"
 	LAYOUT_MDSV2_CLIENT_DIR             = "/mds-client" // change mdsv2-client to mds-client
 	LAYOUT_DINGO_CLI_CONFIG_USER_DIR    = "/root/.dingo"
@@ -170,6 +172,7 @@ func (dc *DeployConfig) GetPrefix() string         { return dc.getString(CONFIG_
 func (dc *DeployConfig) GetReportUsage() bool      { return dc.getBool(CONFIG_REPORT_USAGE) }
 func (dc *DeployConfig) GetContainerImage() string { return dc.getString(CONFIG_CONTAINER_IMAGE) }
 func (dc *DeployConfig) GetLogDir() string         { return dc.getString(CONFIG_LOG_DIR) }
+func (dc *DeployConfig) GetDeployMode() string     { return dc.getString(CONFIG_DEPLOY_MODE) }
 func (dc *DeployConfig) GetDataDir() string {
 	if dc.GetRole() == ROLE_DINGODB_EXECUTOR || dc.GetRole() == ROLE_DINGODB_WEB || dc.GetRole() == ROLE_DINGODB_PROXY {
 		return "-"
@@ -302,6 +305,31 @@ func (dc *DeployConfig) GetMdsStorageUrl() string {
 	return dc.getString(CONFIG_MDS_STORAGE_URL)
 }
 
+// GetCacheDir returns the cache directory on the host for the cache service.
+func (dc *DeployConfig) GetCacheDir() string {
+	if dc.GetRole() == ROLE_FS_CACHE {
+		return dc.getString(CONFIG_CACHE_DIR)
+	}
+	return "-"
+}
+
+// GetCacheSize returns the cache capacity in MiB.
+func (dc *DeployConfig) GetCacheSize() int {
+	return dc.getInt(CONFIG_CACHE_SIZE)
+}
+
+func (dc *DeployConfig) GetCacheGroup() string {
+	return dc.getString(CONFIG_CACHE_GROUP)
+}
+
+// GetCacheMemberId returns the member id of the cache service, it is derived
+// from the listen address so the node keeps its identity in the cache group
+// across restart and upgrade.
+func (dc *DeployConfig) GetCacheMemberId() string {
+	addr := fmt.Sprintf("%s:%d", dc.GetListenIp(), dc.GetListenPort())
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(addr)).String()
+}
+
 type (
 	ConfFile struct {
 		Name       string
@@ -319,6 +347,7 @@ type (
 		ServiceConfDir     string // /dingofs/mds/conf
 		ServiceLogDir      string // /dingofs/mds/logs
 		ServiceDataDir     string // /dingofs/mds/data
+		ServiceCacheDir    string // /dingofs/cache/cache
 		ServiceConfPath    string // /dingofs/mds/conf/mds.conf
 		ServiceConfSrcPath string // /dingofs/conf/mds.conf
 		ServiceConfFiles   []ConfFile
@@ -461,6 +490,7 @@ func (dc *DeployConfig) GetProjectLayout() Layout {
 		ServiceConfDir:     serviceRootDir + LAYOUT_SERVICE_CONF_DIR,
 		ServiceLogDir:      serviceLogDir,
 		ServiceDataDir:     serviceDataDir,
+		ServiceCacheDir:    serviceRootDir + LAYOUT_SERVICE_CACHE_DIR,
 		ServiceConfPath:    fmt.Sprintf("%s/%s.conf", serviceConfDir, role),
 		ServiceConfSrcPath: fmt.Sprintf("%s/%s.conf", confSrcDir, role),
 		ServiceConfFiles:   serviceConfFiles,
@@ -66,6 +66,10 @@ const (
 	DEFAULT_DINGODB_PROXY_SERVER_PORT       = 13000
 	DEFAULT_DINGODB_WEB_EXPORT_PORT         = 19100
 	DEFAULT_DINGO_MDS_CLUSTER_ID            = 0
+	DEFAULT_CACHE_LISTEN_PORT               = 9300
+	DEFAULT_CACHE_SIZE                      = 102400 // MiB
+	DEFAULT_CACHE_GROUP                     = "default"
+	DEFAULT_DEPLOY_MODE                     = DEPLOY_MODE_CONTAINER
 )
 
 type (
@@ -162,6 +166,15 @@ var (
 		},
 	)
 
+	// deploy mode: container (default) or systemd (bare-metal)
+	CONFIG_DEPLOY_MODE = itemset.insert(
+		KIND_DINGO,
+		"deploy_mode",
+		REQUIRE_STRING,
+		true,
+		DEFAULT_DEPLOY_MODE,
+	)
+
 	CONFIG_LOG_DIR = itemset.insert(
 		KIND_DINGO,
 		"log_dir",
@@ -246,6 +259,8 @@ var (
 				return DEFAULT_STORE_SERVER_PORT
 			case ROLE_DINGODB_EXECUTOR:
 				return DEFAULT_DINGODB_EXECUTOR_MYSQL_PORT
+			case ROLE_FS_CACHE:
+				return DEFAULT_CACHE_LISTEN_PORT
 			}
 			return nil
 		},
@@ -633,6 +648,48 @@ var (
 		false,
 		nil,
 	)
+
+	// cache dir on host, defaults to the cache directory under data_dir
+	CONFIG_CACHE_DIR = itemset.insert(
+		KIND_DINGOFS,
+		"cache.dir",
+		REQUIRE_STRING,
+		true,
+		func(dc *DeployConfig) interface{} {
+			if dc.GetRole() != ROLE_FS_CACHE {
+				return nil
+			} else if dataDir := dc.getString(CONFIG_DATA_DIR); len(dataDir) > 0 {
+				return path.Join(dataDir, "cache")
+			}
+			return nil
+		},
+	)
+
+	CONFIG_CACHE_SIZE = itemset.insert(
+		KIND_DINGOFS,
+		"cache.size",
+		REQUIRE_POSITIVE_INTEGER,
+		true,
+		func(dc *DeployConfig) interface{} {
+			if dc.GetRole() == ROLE_FS_CACHE {
+				return DEFAULT_CACHE_SIZE
+			}
+			return nil
+		},
+	)
+
+	CONFIG_CACHE_GROUP = itemset.insert(
+		KIND_DINGOFS,
+		"cache.group",
+		REQUIRE_STRING,
+		true,
+		func(dc *DeployConfig) interface{} {
+			if dc.GetRole() == ROLE_FS_CACHE {
+				return DEFAULT_CACHE_GROUP
+			}
+			return nil
+		},
+	)
 )
 
 func (i *item) Key() string {
@@ -18,6 +18,7 @@ package topology
 
 import (
 	"bytes"
+	"strings"
 
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/utils"
@@ -51,6 +52,8 @@ type (
 		SnapshotcloneServices Service `mapstructure:"snapshotclone_services"`
 		// dingofs mds v2
 		MdsV2Services Service `mapstructure:"mdsv2_services"`
+		// dingofs cache
+		CacheServices Service `mapstructure:"cache_services"`
 		// dingo-store
 		CoordinatorServices Service `mapstructure:"coordinator_services"`
 		StoreServices       Service `mapstructure:"store_services"`
@@ -121,6 +124,22 @@ func newIfNil(config map[string]interface{}) map[string]interface{} {
 	return config
 }
 
+func checkDeployMode(dc *DeployConfig) error {
+	switch dc.GetDeployMode() {
+	case DEPLOY_MODE_CONTAINER:
+		return nil
+	case DEPLOY_MODE_SYSTEMD:
+		if utils.Contains(SYSTEMD_ROLES, dc.GetRole()) {
+			return nil
+		}
+		return errno.ERR_UNSUPPORT_DEPLOY_MODE.
+			F("%s: deploy mode '%s' not supported for role '%s', only %s can run as systemd service",
+				dc.GetId(), DEPLOY_MODE_SYSTEMD, dc.GetRole(), strings.Join(SYSTEMD_ROLES, ", "))
+	}
+	return errno.ERR_UNSUPPORT_DEPLOY_MODE.
+		F("%s: %s", CONFIG_DEPLOY_MODE.Key(), dc.GetDeployMode())
+}
+
 func ParseTopology(data string, ctx *Context) ([]*DeployConfig, error) {
 	if len(data) == 0 {
 		return nil, errno.ERR_EMPTY_CLUSTER_TOPOLOGY
@@ -157,6 +176,10 @@ func ParseTopology(data string, ctx *Context) ([]*DeployConfig, error) {
 			roles = append(roles, DINGOFS_ROLES...)
 			ctx.Add(CTX_KEY_MDS_VERSION, CTX_VAL_MDS_V1)
 		}
+		// cache nodes start after mds, they join cache group through mds
+		if topology.CacheServices.Deploy != nil {
+			roles = append(roles, ROLE_FS_CACHE)
+		}
 	case KIND_DINGOSTORE:
 		roles = append(roles, DINGOSTORE_ROLES...)
 	case KIND_DINGODB:
@@ -182,6 +205,8 @@ func ParseTopology(data string, ctx *Context) ([]*DeployConfig, error) {
 			services = topology.MetaserverServices
 		case ROLE_FS_MDS:
 			services = topology.MdsServices
+		case ROLE_FS_CACHE:
+			services = topology.CacheServices
 		case ROLE_COORDINATOR:
 			services = topology.CoordinatorServices
 		case ROLE_STORE:
@@ -258,6 +283,8 @@ func ParseTopology(data string, ctx *Context) ([]*DeployConfig, error) {
 			return nil, err // already is error code
 		} else if err = dc.Build(); err != nil {
 			return nil, err // already is error code
+		} else if err = checkDeployMode(dc); err != nil {
+			return nil, err // already is error code
 		} else if exist[dc.GetId()] {
 			// actually the dc.GetId() return configure id
 			return nil, errno.ERR_DUPLICATE_SERVICE_ID.
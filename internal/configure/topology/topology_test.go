@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeployModeConfig(t *testing.T, role, mode string) *DeployConfig {
+	config := map[string]interface{}{}
+	if len(mode) > 0 {
+		config[CONFIG_DEPLOY_MODE.Key()] = mode
+	}
+	dc, err := NewDeployConfig(nil, KIND_DINGOFS, role, "10.0.0.1", "", 1, 0, 0, config)
+	require.NoError(t, err)
+	return dc
+}
+
+func TestCheckDeployMode(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, role := range []string{ROLE_FS_MDS, ROLE_COORDINATOR, ROLE_STORE} {
+		assert.NoError(checkDeployMode(newDeployModeConfig(t, role, "")))
+		assert.NoError(checkDeployMode(newDeployModeConfig(t, role, DEPLOY_MODE_CONTAINER)))
+	}
+	for _, role := range SYSTEMD_ROLES {
+		assert.NoError(checkDeployMode(newDeployModeConfig(t, role, DEPLOY_MODE_SYSTEMD)))
+	}
+
+	// coordinator and store can't run as systemd service
+	for _, role := range []string{ROLE_COORDINATOR, ROLE_STORE} {
+		err := checkDeployMode(newDeployModeConfig(t, role, DEPLOY_MODE_SYSTEMD))
+		if assert.Error(err) {
+			e := err.(*errno.ErrorCode)
+			assert.Equal(errno.ERR_UNSUPPORT_DEPLOY_MODE.GetCode(), e.GetCode())
+			assert.Contains(e.GetClue(), "only mds, mds-client, cache can run as systemd service")
+		}
+	}
+
+	err := checkDeployMode(newDeployModeConfig(t, ROLE_FS_MDS, "baremetal"))
+	assert.Error(err)
+}
@@ -151,6 +151,7 @@ func (e *ErrorCode) Error() string {
  *     * 341: invalid configure value
  *   35*: client.yaml
  *   36*: plugin
+ *   37*: dingo.yaml
  *
  * 4xx: common
  *   40*: hosts
@@ -228,15 +229,41 @@ var (
 	// 115: database/SQL (execute SQL statement: audit table)
 	ERR_GET_AUDIT_LOGS_FAILE = EC(115000, "execute SQL failed which get audit logs")
 	// 116: database/SQL (execute SQL statement: any table)
-	ERR_INSERT_CLIENT_CONFIG_FAILED = EC(116000, "execute SQL failed which insert client config")
-	ERR_SELECT_CLIENT_CONFIG_FAILED = EC(116001, "execute SQL failed which select client config")
-	ERR_DELETE_CLIENT_CONFIG_FAILED = EC(116002, "execute SQL failed which delete client config")
+	ERR_INSERT_CLIENT_CONFIG_FAILED      = EC(116000, "execute SQL failed which insert client config")
+	ERR_SELECT_CLIENT_CONFIG_FAILED      = EC(116001, "execute SQL failed which select client config")
+	ERR_DELETE_CLIENT_CONFIG_FAILED      = EC(116002, "execute SQL failed which delete client config")
+	ERR_SET_CACHE_MEMBER_DRAIN_FAILED    = EC(116003, "execute SQL failed which set cache member drain state")
+	ERR_SELECT_CACHE_MEMBER_DRAIN_FAILED = EC(116004, "execute SQL failed which select cache member drain state")
+	ERR_DELETE_CACHE_MEMBER_DRAIN_FAILED = EC(116005, "execute SQL failed which delete cache member drain state")
+	ERR_SET_UPGRADE_RECORD_FAILED        = EC(116006, "execute SQL failed which set service upgrade record")
+	ERR_SELECT_UPGRADE_RECORD_FAILED     = EC(116007, "execute SQL failed which select service upgrade record")
+	ERR_DELETE_UPGRADE_RECORD_FAILED     = EC(116008, "execute SQL failed which delete service upgrade record")
 	// 117: database/SQL (execute SQL statement: monitor table)
 	ERR_GET_MONITOR_FAILED     = EC(117000, "execute SQL failed while get monitor")
 	ERR_REPLACE_MONITOR_FAILED = EC(117001, "execute SQL failed while replace monitor")
 	ERR_UPDATE_MONITOR_FAILED  = EC(117002, "execute SQL failed while update monitor")
+	// 118: database/SQL (execute SQL statement: warmup profiles table)
+	ERR_INSERT_WARMUP_PROFILE_FAILED = EC(118000, "execute SQL failed which insert warmup profile")
+	ERR_GET_WARMUP_PROFILE_FAILED    = EC(118001, "execute SQL failed which get warmup profile")
+	ERR_UPDATE_WARMUP_PROFILE_FAILED = EC(118002, "execute SQL failed which update warmup profile")
+	ERR_DELETE_WARMUP_PROFILE_FAILED = EC(118003, "execute SQL failed which delete warmup profile")
+	// 119: database/SQL (execute SQL statement: playbook tables)
+	ERR_INSERT_PLAYBOOK_RUN_FAILED     = EC(119000, "execute SQL failed which insert playbook run")
+	ERR_SELECT_PLAYBOOK_RUN_FAILED     = EC(119001, "execute SQL failed which select playbook run")
+	ERR_SET_PLAYBOOK_STEP_FAILED       = EC(119002, "execute SQL failed which set playbook step")
+	ERR_SELECT_PLAYBOOK_STEPS_FAILED   = EC(119003, "execute SQL failed which select playbook steps")
+	ERR_SELECT_PLAYBOOK_TASKS_FAILED   = EC(119004, "execute SQL failed which select playbook tasks")
+	ERR_SET_PLAYBOOK_RUN_STATUS_FAILED = EC(119005, "execute SQL failed which set playbook run status")
 
 	// 200: command options (hosts)
+	// 201: command options (global)
+	ERR_UNSUPPORT_DRY_RUN_FORMAT  = EC(201000, "unsupport dry run format (text/json)")
+	ERR_DRY_RUN_NOT_SUPPORTED     = EC(201001, "command not support dry run")
+	ERR_PRINT_DRY_RUN_PLAN_FAILED = EC(201002, "print dry run plan failed")
+
+	// 202: command options (history)
+	ERR_INVALID_PLAYBOOK_RUN_ID = EC(202000, "invalid playbook run id")
+	ERR_PLAYBOOK_RUN_NOT_FOUND  = EC(202001, "playbook run not found")
 
 	// 210: command options (cluster)
 	ERR_ID_NOT_FOUND                   = EC(210000, "id not found")
@@ -248,7 +275,12 @@ var (
 	ERR_UNSUPPORT_DINGODB_ROLE         = EC(210007, "unsupport dingodb role (coordinator/store/executor/document/index/diskann/proxy/web)")
 	ERR_UNSUPPORT_DINGOSTORE_ROLE      = EC(210008, "unsupport dingo-store role (coordinator/store/document/index/diskann)")
 	// TODO: please check pool set disk type
-	ERR_INVALID_DISK_TYPE = EC(210009, "poolset disk type must be lowercase and can only be one of ssd, hdd and nvme")
+	ERR_INVALID_DISK_TYPE               = EC(210009, "poolset disk type must be lowercase and can only be one of ssd, hdd and nvme")
+	ERR_INVALID_MAX_UNAVAILABLE         = EC(210010, "max unavailable requires a positive integer")
+	ERR_NO_UPGRADE_RECORD               = EC(210011, "no upgrade record of services, nothing to rollback")
+	ERR_NO_FAILED_RUN_TO_RESUME         = EC(210012, "no failed run to resume")
+	ERR_TOPOLOGY_CHANGED_SINCE_LAST_RUN = EC(210013, "cluster topology changed since the last run, can't resume")
+	ERR_STEPS_CHANGED_SINCE_LAST_RUN    = EC(210014, "playbook steps changed since the last run, can't resume")
 
 	// 220: commad options (client common)
 	ERR_UNSUPPORT_CLIENT_KIND = EC(220000, "unsupport client kind")
@@ -283,9 +315,10 @@ var (
 	// 310: configure (dingocli.cfg: parse failed)
 	ERR_PARSE_DINGOADM_CONFIGURE_FAILED = EC(310000, "parse dingocli configure failed")
 	// 311: configure (dingocli.cfg: invalid configure value)
-	ERR_UNSUPPORT_DINGOADM_LOG_LEVEL      = EC(311000, "unsupport dingocli log level")
-	ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM = EC(311001, "unsupport dingocli configure item")
-	ERR_UNSUPPORT_DINGOADM_DATABASE_URL   = EC(311002, "unsupport dingocli database url")
+	ERR_UNSUPPORT_DINGOADM_LOG_LEVEL        = EC(311000, "unsupport dingocli log level")
+	ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM   = EC(311001, "unsupport dingocli configure item")
+	ERR_UNSUPPORT_DINGOADM_DATABASE_URL     = EC(311002, "unsupport dingocli database url")
+	ERR_UNSUPPORT_DINGOADM_CONTAINER_ENGINE = EC(311003, "unsupport dingocli container engine")
 
 	// 320: configure (hosts.yaml: parse failed)
 	ERR_HOSTS_FILE_NOT_FOUND   = EC(320000, "hosts file not found")
@@ -324,6 +357,7 @@ var (
 	ERR_INSTANCES_REQUIRES_POSITIVE_INTEGER = EC(331002, "instances requires a positive integer")
 	ERR_INVALID_VARIABLE_SECTION            = EC(331003, "invalid variable section")
 	ERR_DUPLICATE_SERVICE_ID                = EC(331004, "service id is duplicate")
+	ERR_UNSUPPORT_DEPLOY_MODE               = EC(331005, "unsupport deploy mode")
 	// 332: configure (topology.yaml: update topology)
 	ERR_DELETE_SERVICE_WHILE_COMMIT_TOPOLOGY_IS_DENIED   = EC(332000, "delete service while commit topology is denied")
 	ERR_ADD_SERVICE_WHILE_COMMIT_TOPOLOGY_IS_DENIED      = EC(332001, "add service while commit topology is denied")
@@ -337,6 +371,11 @@ var (
 	ERR_NO_SERVICES_FOR_MIGRATING                        = EC(332009, "no service for migrating")
 	ERR_REQUIRE_SAME_ROLE_SERVICES_FOR_MIGRATING         = EC(332010, "require same role services for migrating")
 	ERR_REQUIRE_WHOLE_HOST_SERVICES_FOR_MIGRATING        = EC(332011, "require whole host services for migrating")
+	ERR_ADD_SERVICE_WHILE_SCALE_IN_CLUSTER_IS_DENIED     = EC(332012, "add service while scale in cluster is denied")
+	ERR_NO_SERVICES_FOR_SCALE_IN_CLUSTER                 = EC(332013, "no service for scale in cluster")
+	ERR_UNSUPPORT_ROLE_FOR_SCALE_CLUSTER                 = EC(332014, "unsupport role for scale cluster (etcd/coordinator/mds-client)")
+	ERR_MDS_OWNS_FS_PARTITION_WHILE_SCALE_IN             = EC(332015, "mds still owns fs partitions, move them to other mds before scale in")
+	ERR_STORE_REPLICA_NOT_ENOUGH_WHILE_SCALE_IN          = EC(332016, "scale in store would remove the last copy of some replicas")
 
 	// 340: configure (format.yaml: parse failed)
 	ERR_FORMAT_CONFIGURE_FILE_NOT_EXIST = EC(340000, "format configure file not exits")
@@ -362,6 +401,11 @@ var (
 	ERR_PARSE_GATEWAY_CONFIGURE_FAILED = EC(360000, "parse client configure failed")
 	ERR_GATEWAY_MDSADDR_EMPTY          = EC(360001, "dingofs mdsaddr is empty")
 
+	// 370: configure (dingo.yaml: parse failed)
+	ERR_PARSE_DINGO_CONFIGURE_FAILED = EC(370000, "parse dingo.yaml failed")
+	// 371: configure (dingo.yaml: invalid configure value)
+	ERR_INVALID_CONCURRENCY = EC(371000, "concurrency requires a non-negative integer")
+
 	// 400: common (hosts)
 	ERR_HOST_NOT_FOUND = EC(400000, "host not found")
 
@@ -405,6 +449,39 @@ var (
 	// 450: common (playground)
 	ERR_PLAYGROUND_NOT_FOUND = EC(450000, "playground not found")
 
+	// 460: common (warmup profile)
+	ERR_WARMUP_PROFILE_NOT_FOUND      = EC(460000, "warmup profile not found")
+	ERR_WARMUP_PROFILE_ALREADY_EXIST  = EC(460001, "warmup profile already exist")
+	ERR_DECODE_WARMUP_PROFILE_FAILED  = EC(460002, "decode warmup profile failed")
+	ERR_UPDATE_WARMUP_SCHEDULE_FAILED = EC(460003, "update warmup schedule in crontab failed")
+	ERR_RUN_WARMUP_PROFILE_FAILED     = EC(460004, "run warmup profile failed")
+
+	// 470: common (cache member)
+	ERR_CACHE_MEMBER_NOT_FOUND         = EC(470000, "cache member not found")
+	ERR_CACHE_MEMBER_NOT_DRAINING      = EC(470001, "cache member is not draining")
+	ERR_WAIT_CACHE_MEMBER_IDLE_TIMEOUT = EC(470002, "wait cache member traffic moved timeout")
+	ERR_PARSE_CACHE_MANIFEST_FAILED    = EC(470003, "parse cache manifest failed")
+	ERR_CACHE_MEMBER_CAPACITY_UNKNOWN  = EC(470004, "capacity of cache member is unknown")
+	ERR_CACHE_INSTANCE_NOT_FOUND       = EC(470005, "cache instance not found")
+	ERR_CACHE_INSTANCE_ALREADY_RUNNING = EC(470006, "cache instance is already running")
+	ERR_START_CACHE_INSTANCE_FAILED    = EC(470007, "start cache instance failed")
+	ERR_STOP_CACHE_INSTANCE_FAILED     = EC(470008, "stop cache instance failed")
+	ERR_DISK_CACHE_DIRECTORY_NOT_FOUND = EC(470009, "disk cache directory not found")
+	ERR_COLLECT_DISK_CACHE_FAILED      = EC(470010, "collect disk cache failed")
+	ERR_CLEAN_DISK_CACHE_FAILED        = EC(470011, "clean disk cache failed")
+
+	// 480: common (cluster backup)
+	ERR_NO_BACKUP_TARGET_IN_TOPOLOGY  = EC(480000, "no service to backup in cluster topology")
+	ERR_BACKUP_NOT_FOUND              = EC(480001, "cluster backup not found")
+	ERR_DECODE_BACKUP_MANIFEST_FAILED = EC(480002, "decode backup manifest failed")
+	ERR_WRITE_BACKUP_MANIFEST_FAILED  = EC(480003, "write backup manifest failed")
+	ERR_BACKUP_CHECKSUM_MISMATCH      = EC(480004, "backup file checksum mismatch")
+	ERR_UNSUPPORT_RESTORE_BACKUP_KIND = EC(480005, "unsupport restore backup kind")
+	ERR_PRUNE_BACKUP_FAILED           = EC(480006, "prune expired backups failed")
+	ERR_UPDATE_BACKUP_SCHEDULE_FAILED = EC(480007, "update backup schedule in crontab failed")
+	ERR_INVALID_BACKUP_OPTION         = EC(480008, "invalid backup option")
+	ERR_INVALID_BACKUP_ID             = EC(480009, "invalid backup id")
+
 	// 500: checker (topology/s3)
 	ERR_INVALID_S3_ACCESS_KEY  = EC(500000, "invalid S3 access key")
 	ERR_INVALID_S3_SECRET_KEY  = EC(500001, "invalid S3 secret key")
@@ -413,6 +490,8 @@ var (
 	// 501: checker (topology/directory)
 	ERR_DIRECTORY_REQUIRE_ABSOLUTE_PATH = EC(501000, "directory must be an absolute path")
 	ERR_DATA_DIRECTORY_ALREADY_IN_USE   = EC(501001, "data directory already in use")
+	ERR_CACHE_DIRECTORY_REQUIRED        = EC(501002, "cache directory is required for cache service")
+	ERR_CACHE_DIRECTORY_ALREADY_IN_USE  = EC(501003, "cache directory already in use")
 	// 502: checker (topology/address)
 	ERR_DUPLICATE_LISTEN_ADDRESS = EC(502000, "listen address is duplicate")
 	// 503: checker (topology/service)
@@ -436,7 +515,7 @@ var (
 	ERR_USER_NOT_FOUND                                     = EC(520000, "user not found")
 	ERR_HOSTNAME_NOT_RESOLVED                              = EC(520001, "hostname not resolved")
 	ERR_CREATE_DIRECOTRY_PERMISSION_DENIED                 = EC(520002, "create direcotry permission denied")
-	ERR_EXECUTE_CONTAINER_ENGINE_COMMAND_PERMISSION_DENIED = EC(520003, "execute docker/podman command permission denied")
+	ERR_EXECUTE_CONTAINER_ENGINE_COMMAND_PERMISSION_DENIED = EC(520003, "execute docker/podman/nerdctl command permission denied")
 
 	// 530: checker (kernel)
 	ERR_UNRECOGNIZED_KERNEL_VERSION              = EC(530000, "unrecognized kernel version")
@@ -463,9 +542,10 @@ var (
 	ERR_INVALID_DINGOFS_CLIENT_S3_BUCKET_NAME = EC(570003, "invalid dingofs client S3 bucket name")
 
 	// 590: checker (others)
-	ERR_CONTAINER_ENGINE_NOT_INSTALLED = EC(590000, "container engine docker/podman not installed")
+	ERR_CONTAINER_ENGINE_NOT_INSTALLED = EC(590000, "container engine docker/podman/nerdctl not installed")
 	ERR_DOCKER_DAEMON_IS_NOT_RUNNING   = EC(590001, "docker daemon is not running")
 	ERR_NO_SPACE_LEFT_ON_DEVICE        = EC(590002, "no space left on device")
+	ERR_CONTAINER_ENGINE_NOT_ROOTLESS  = EC(590003, "container engine is not running rootless")
 
 	// 600: exeute task (common)
 	ERR_EXECUTE_COMMAND_TIMED_OUT = EC(600000, "execute command timed out")
@@ -509,6 +589,7 @@ var (
 	ERR_SECURE_COPY_FILE_TO_REMOTE_FAILED          = EC(620026, "secure copy file to remote failed (scp)")
 	ERR_GET_BLOCK_DEVICE_UUID_FAILED               = EC(620027, "get block device uuid failed (blkid)")
 	ERR_RESERVE_FILESYSTEM_BLOCKS_FAILED           = EC(620028, "reserve filesystem blocks (tune2fs)")
+	ERR_CONTROL_SYSTEMD_UNIT_FAILED                = EC(620029, "control systemd unit failed (systemctl)")
 	ERR_RUN_SCRIPT_FAILED                          = EC(620998, "run script failed (bash script.sh)")
 	ERR_RUN_A_BASH_COMMAND_FAILED                  = EC(620999, "run a bash command failed (bash -c)")
 
@@ -536,10 +617,22 @@ var (
 
 	// 650: mdsv2
 	ERR_CREATE_META_TABLE_FAILED = EC(650000, "create meta table failed")
+	ERR_JOIN_CACHE_GROUP_FAILED  = EC(650001, "cache node join cache group failed")
+	ERR_BACKUP_MDS_META_FAILED   = EC(650002, "backup mds meta failed")
+	ERR_RESTORE_MDS_META_FAILED  = EC(650003, "restore mds meta failed")
+	ERR_BACKUP_ETCD_DATA_FAILED  = EC(650004, "backup etcd data failed")
+	ERR_LEAVE_CACHE_GROUP_FAILED = EC(650005, "cache node leave cache group failed")
 
 	// 660: rpc
 	ERR_RPC_FAILED = EC(660000, "rpc request to mds cluster failed")
 
+	// 670: upgrade
+	ERR_SERVICE_NOT_HEALTHY_AFTER_UPGRADE = EC(670000, "service not healthy after upgrade, rollout stopped")
+
+	// 680: systemd
+	ERR_COMPONENT_BINARY_NOT_INSTALLED = EC(680000, "component binary not installed")
+	ERR_SYSTEMD_SERVICE_NOT_ACTIVE     = EC(680001, "systemd service is not active")
+
 	// 690: execuetr task (others)
 	ERR_START_CRONTAB_IN_CONTAINER_FAILED = EC(690000, "start crontab in container failed")
 
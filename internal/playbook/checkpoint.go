@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package playbook
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/storage"
+	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/internal/tasks"
+	"github.com/dingodb/dingocli/internal/utils"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+)
+
+/*
+ * checkpoint persists a playbook run into database:
+ *   playbook_runs: operation, topology hash, status and timing of the run,
+ *                  linked with the audit log of the command
+ *   playbook_steps: status and timing of each step
+ *   playbook_tasks: status, timing, error and output of each task (one service or host) in step
+ *
+ * a failed run can be resumed if the topology is not changed,
+ * the tasks which already succeeded are skipped. steps are compared by
+ * their stable key, the value of step type changes once a type is inserted.
+ */
+type checkpoint struct {
+	dingocli  *cli.DingoCli
+	operation string
+	runId     int64
+	resumed   bool
+	succeeded map[string]bool // step index + task key
+	mutex     sync.Mutex
+}
+
+func taskKey(index int, t string) string {
+	return fmt.Sprintf("%d/%s", index, t)
+}
+
+func topologyHash(dingocli *cli.DingoCli) string {
+	return utils.MD5Sum(dingocli.ClusterTopologyData())
+}
+
+// EnableCheckpoint persists the run of playbook as the specified operation, e.g. deploy,
+// which can be browsed by `dingo history`
+func (p *Playbook) EnableCheckpoint(operation string) {
+	p.checkpoint = &checkpoint{
+		dingocli:  p.dingocli,
+		operation: operation,
+		succeeded: map[string]bool{},
+	}
+}
+
+// Resume continues the last failed run of the operation, it must be invoked after all steps added
+func (p *Playbook) Resume(operation string) error {
+	p.EnableCheckpoint(operation)
+	dingocli := p.dingocli
+	runs, err := dingocli.Storage().GetLastPlaybookRun(dingocli.ClusterId(), operation)
+	if err != nil {
+		return errno.ERR_SELECT_PLAYBOOK_RUN_FAILED.E(err)
+	} else if len(runs) == 0 || runs[0].Status == comm.PLAYBOOK_STATUS_SUCCESS {
+		return errno.ERR_NO_FAILED_RUN_TO_RESUME.F("operation: %s", operation)
+	}
+
+	run := runs[0]
+	if run.TopologyHash != topologyHash(dingocli) {
+		return errno.ERR_TOPOLOGY_CHANGED_SINCE_LAST_RUN.F("run: %d", run.Id)
+	}
+
+	steps, err := dingocli.Storage().GetPlaybookSteps(run.Id)
+	if err != nil {
+		return errno.ERR_SELECT_PLAYBOOK_STEPS_FAILED.E(err)
+	} else if len(steps) != len(p.steps) {
+		return errno.ERR_STEPS_CHANGED_SINCE_LAST_RUN.
+			F("run %d has %d steps, now %d steps", run.Id, len(steps), len(p.steps))
+	}
+	for i, step := range steps {
+		if step.Key != StepKey(p.steps[i].Type) {
+			return errno.ERR_STEPS_CHANGED_SINCE_LAST_RUN.F("step %d: %s", i, step.Name)
+		}
+	}
+
+	items, err := dingocli.Storage().GetPlaybookTasks(run.Id)
+	if err != nil {
+		return errno.ERR_SELECT_PLAYBOOK_TASKS_FAILED.E(err)
+	}
+	for _, item := range items {
+		if item.Status == comm.PLAYBOOK_STATUS_SUCCESS {
+			p.checkpoint.succeeded[taskKey(item.StepIndex, item.TaskKey)] = true
+		}
+	}
+
+	p.checkpoint.runId = run.Id
+	p.checkpoint.resumed = true
+	return nil
+}
+
+func (cp *checkpoint) begin(steps []*PlaybookStep) error {
+	if cp.resumed {
+		err := cp.dingocli.Storage().SetPlaybookRunStatus(cp.runId, comm.PLAYBOOK_STATUS_RUNNING, time.Now())
+		if err != nil {
+			return errno.ERR_SET_PLAYBOOK_RUN_STATUS_FAILED.E(err)
+		}
+		return nil
+	}
+
+	now := time.Now()
+	runId, err := cp.dingocli.Storage().InsertPlaybookRun(storage.PlaybookRun{
+		ClusterId:    cp.dingocli.ClusterId(),
+		AuditId:      cp.dingocli.AuditId(),
+		Operation:    cp.operation,
+		TopologyHash: topologyHash(cp.dingocli),
+		Status:       comm.PLAYBOOK_STATUS_RUNNING,
+		CreateTime:   now,
+		EndTime:      now,
+	})
+	if err != nil {
+		return errno.ERR_INSERT_PLAYBOOK_RUN_FAILED.E(err)
+	}
+	cp.runId = runId
+
+	for i, step := range steps {
+		err := cp.setStep(i, step, step.Name, comm.PLAYBOOK_STATUS_PENDING, time.Time{}, time.Time{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cp *checkpoint) setStep(index int, step *PlaybookStep, name string, status int,
+	startTime, endTime time.Time) error {
+	err := cp.dingocli.Storage().SetPlaybookStep(storage.PlaybookStep{
+		RunId:     cp.runId,
+		StepIndex: index,
+		Key:       StepKey(step.Type),
+		Name:      name,
+		Status:    status,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return errno.ERR_SET_PLAYBOOK_STEP_FAILED.E(err)
+	}
+	return nil
+}
+
+// skip the succeeded tasks and persist the status of others after executed,
+// returns the number of skipped tasks
+func (cp *checkpoint) track(index int, step *PlaybookStep, ts *tasks.Tasks) int {
+	n := 0
+	if !RERUN_ON_RESUME_STEPS[step.Type] {
+		n = ts.Skip(func(t *task.Task) bool {
+			return cp.succeeded[taskKey(index, t.Tid())]
+		})
+	}
+
+	ts.OnDone(func(t *task.Task, err error) {
+		status, message := comm.PLAYBOOK_STATUS_SUCCESS, ""
+		if err != nil {
+			status, message = comm.PLAYBOOK_STATUS_FAIL, err.Error()
+		}
+
+		// sqlite doesn't like concurrent writes
+		cp.mutex.Lock()
+		defer cp.mutex.Unlock()
+		err = cp.dingocli.Storage().SetPlaybookTask(storage.PlaybookTask{
+			RunId:     cp.runId,
+			StepIndex: index,
+			TaskKey:   t.Tid(),
+			ServiceId: t.ServiceId(),
+			Host:      t.Host(),
+			Status:    status,
+			StartTime: t.StartTime(),
+			EndTime:   t.EndTime(),
+			Error:     message,
+			Stdout:    t.Output().Stdout(),
+			Stderr:    t.Output().Stderr(),
+		})
+		if err != nil {
+			log.Error("Set playbook task failed",
+				log.Field("RunId", cp.runId),
+				log.Field("Task", t.Tid()),
+				log.Field("Error", err))
+		}
+	})
+	return n
+}
+
+func (cp *checkpoint) end(err error) {
+	status := comm.PLAYBOOK_STATUS_SUCCESS
+	if err != nil {
+		status = comm.PLAYBOOK_STATUS_FAIL
+	}
+	if err := cp.dingocli.Storage().SetPlaybookRunStatus(cp.runId, status, time.Now()); err != nil {
+		log.Error("Set playbook run status failed",
+			log.Field("RunId", cp.runId),
+			log.Field("Error", err))
+	}
+}
@@ -17,6 +17,8 @@
 package playbook
 
 import (
+	"fmt"
+
 	"github.com/dingodb/dingocli/internal/configure"
 	"github.com/dingodb/dingocli/internal/configure/topology"
 	"github.com/dingodb/dingocli/internal/errno"
@@ -31,6 +33,7 @@ const (
 	// checker
 	CHECK_TOPOLOGY int = iota
 	CHECK_SSH_CONNECT
+	CHECK_CONTAINER_ENGINE
 	CHECK_PERMISSION
 	CHECK_KERNEL_VERSION
 	CHECK_KERNEL_MODULE
@@ -59,6 +62,7 @@ const (
 	START_STORE
 	START_MDSV2_CLI_CONTAINER
 	START_DINGODB_EXECUTOR
+	START_FS_CACHE
 	STOP_SERVICE
 	RESTART_SERVICE
 	CREATE_META_TABLES
@@ -68,6 +72,8 @@ const (
 	BACKUP_ETCD_DATA
 	CHECK_MDS_ADDRESS
 	CHECK_STORE_HEALTH
+	JOIN_CACHE_GROUP
+	RECORD_SERVICE_IMAGE
 	INIT_CLIENT_STATUS
 	GET_CLIENT_STATUS
 
@@ -119,10 +125,140 @@ const (
 	// dingo executor
 	SYNC_JAVA_OPTS
 
+	// backup
+	BACKUP_MDS_META
+	RESTORE_MDS_META
+
 	// unknown
 	UNKNOWN
 )
 
+// STEP_KEYS are the stable names of step types persisted by checkpoint,
+// the value of step type changes once a new type is inserted
+var STEP_KEYS = map[int]string{
+	// checker
+	CHECK_TOPOLOGY:              "check_topology",
+	CHECK_SSH_CONNECT:           "check_ssh_connect",
+	CHECK_CONTAINER_ENGINE:      "check_container_engine",
+	CHECK_PERMISSION:            "check_permission",
+	CHECK_KERNEL_VERSION:        "check_kernel_version",
+	CHECK_KERNEL_MODULE:         "check_kernel_module",
+	CHECK_PORT_IN_USE:           "check_port_in_use",
+	CHECK_DESTINATION_REACHABLE: "check_destination_reachable",
+	START_HTTP_SERVER:           "start_http_server",
+	CHECK_NETWORK_FIREWALL:      "check_network_firewall",
+	GET_HOST_DATE:               "get_host_date",
+	CHECK_HOST_DATE:             "check_host_date",
+	CHECK_S3:                    "check_s3",
+	CLEAN_PRECHECK_ENVIRONMENT:  "clean_precheck_environment",
+
+	// common
+	PULL_IMAGE:                 "pull_image",
+	CREATE_CONTAINER:           "create_container",
+	CREATE_MDSV2_CLI_CONTAINER: "create_mdsv2_cli_container",
+	SYNC_CONFIG:                "sync_config",
+	START_SERVICE:              "start_service",
+	START_ETCD:                 "start_etcd",
+	START_MDS:                  "start_mds",
+	START_CHUNKSERVER:          "start_chunkserver",
+	START_SNAPSHOTCLONE:        "start_snapshotclone",
+	START_METASERVER:           "start_metaserver",
+	START_FS_MDS:               "start_fs_mds",
+	START_COORDINATOR:          "start_coordinator",
+	START_STORE:                "start_store",
+	START_MDSV2_CLI_CONTAINER:  "start_mdsv2_cli_container",
+	START_DINGODB_EXECUTOR:     "start_dingodb_executor",
+	START_FS_CACHE:             "start_fs_cache",
+	STOP_SERVICE:               "stop_service",
+	RESTART_SERVICE:            "restart_service",
+	CREATE_META_TABLES:         "create_meta_tables",
+	INIT_SERVIE_STATUS:         "init_servie_status",
+	GET_SERVICE_STATUS:         "get_service_status",
+	CLEAN_SERVICE:              "clean_service",
+	BACKUP_ETCD_DATA:           "backup_etcd_data",
+	CHECK_MDS_ADDRESS:          "check_mds_address",
+	CHECK_STORE_HEALTH:         "check_store_health",
+	JOIN_CACHE_GROUP:           "join_cache_group",
+	RECORD_SERVICE_IMAGE:       "record_service_image",
+	INIT_CLIENT_STATUS:         "init_client_status",
+	GET_CLIENT_STATUS:          "get_client_status",
+
+	// dingodb
+	START_DINGODB_DOCUMENT: "start_dingodb_document",
+	START_DINGODB_INDEX:    "start_dingodb_index",
+	START_DINGODB_DISKANN:  "start_dingodb_diskann",
+	START_DINGODB_PROXY:    "start_dingodb_proxy",
+	START_DINGODB_WEB:      "start_dingodb_web",
+
+	// bs
+	FORMAT_CHUNKFILE_POOL: "format_chunkfile_pool",
+	GET_FORMAT_STATUS:     "get_format_status",
+	STOP_FORMAT:           "stop_format",
+	BALANCE_LEADER:        "balance_leader",
+	START_NEBD_SERVICE:    "start_nebd_service",
+	CREATE_VOLUME:         "create_volume",
+	MAP_IMAGE:             "map_image",
+	UNMAP_IMAGE:           "unmap_image",
+
+	// monitor
+	PULL_MONITOR_IMAGE:         "pull_monitor_image",
+	CREATE_MONITOR_CONTAINER:   "create_monitor_container",
+	SYNC_MONITOR_ORIGIN_CONFIG: "sync_monitor_origin_config",
+	SYNC_MONITOR_ALT_CONFIG:    "sync_monitor_alt_config",
+	SYNC_HOSTS_MAPPING:         "sync_hosts_mapping",
+	CLEAN_CONFIG_CONTAINER:     "clean_config_container",
+	START_MONITOR_SERVICE:      "start_monitor_service",
+	RESTART_MONITOR_SERVICE:    "restart_monitor_service",
+	STOP_MONITOR_SERVICE:       "stop_monitor_service",
+	INIT_MONITOR_STATUS:        "init_monitor_status",
+	GET_MONITOR_STATUS:         "get_monitor_status",
+	CLEAN_MONITOR_SERVICE:      "clean_monitor_service",
+	SYNC_GRAFANA_DASHBOARD:     "sync_grafana_dashboard",
+
+	// fs
+	CHECK_CLIENT_S3:   "check_client_s3",
+	CREATE_DINGOFS:    "create_dingofs",
+	MOUNT_FILESYSTEM:  "mount_filesystem",
+	UMOUNT_FILESYSTEM: "umount_filesystem",
+
+	// playground
+	CREATE_PLAYGROUND:     "create_playground",
+	INIT_PLAYGROUND:       "init_playground",
+	START_PLAYGROUND:      "start_playground",
+	REMOVE_PLAYGROUND:     "remove_playground",
+	GET_PLAYGROUND_STATUS: "get_playground_status",
+
+	// dingo executor
+	SYNC_JAVA_OPTS: "sync_java_opts",
+
+	// backup
+	BACKUP_MDS_META:  "backup_mds_meta",
+	RESTORE_MDS_META: "restore_mds_meta",
+
+	// unknown
+	UNKNOWN: "unknown",
+}
+
+// the tasks of these steps only collect state into memory storage which
+// later steps depend on, they are executed again instead of skipped on resume
+var RERUN_ON_RESUME_STEPS = map[int]bool{
+	GET_HOST_DATE:       true,
+	CHECK_STORE_HEALTH:  true,
+	INIT_SERVIE_STATUS:  true,
+	GET_SERVICE_STATUS:  true,
+	INIT_CLIENT_STATUS:  true,
+	GET_CLIENT_STATUS:   true,
+	INIT_MONITOR_STATUS: true,
+	GET_MONITOR_STATUS:  true,
+}
+
+func StepKey(stepType int) string {
+	if key, ok := STEP_KEYS[stepType]; ok {
+		return key
+	}
+	return fmt.Sprintf("unknown_%d", stepType)
+}
+
 func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 	// (1) default tasks execute options
 	config, err := NewSmartConfig(step.Configs)
@@ -144,7 +280,12 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 		// only need to execute task once per host
 		switch step.Type {
 		case CHECK_SSH_CONNECT,
+			CHECK_CONTAINER_ENGINE,
 			GET_HOST_DATE:
+			if step.Type == CHECK_CONTAINER_ENGINE &&
+				config.GetDC(i).GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD {
+				continue // systemd service requires no container engine
+			}
 			host := config.GetDC(i).GetHost()
 			if once[host] {
 				continue
@@ -153,6 +294,9 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 		case PULL_IMAGE:
 			host := config.GetDC(i).GetHost()
 			image := config.GetDC(i).GetContainerImage()
+			if config.GetDC(i).GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD {
+				image = topology.DEPLOY_MODE_SYSTEMD + ":" + config.GetDC(i).GetRole() // install binary of role instead
+			}
 			if once[host+"_"+image] {
 				continue
 			}
@@ -177,6 +321,8 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = checker.NewCheckTopologyTask(dingocli, nil)
 		case CHECK_SSH_CONNECT:
 			t, err = checker.NewCheckSSHConnectTask(dingocli, config.GetDC(i))
+		case CHECK_CONTAINER_ENGINE:
+			t, err = checker.NewCheckContainerEngineTask(dingocli, config.GetDC(i))
 		case CHECK_PERMISSION:
 			if config.GetDC(i).GetRole() == topology.ROLE_FS_MDS_CLI {
 				continue
@@ -213,6 +359,10 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = checker.NewCheckMdsAddressTask(dingocli, config.GetCC(i))
 		case CHECK_STORE_HEALTH:
 			t, err = comm.NewCheckStoreHealthTask(dingocli, config.GetDC(i))
+		case JOIN_CACHE_GROUP:
+			t, err = comm.NewJoinCacheGroupTask(dingocli, config.GetDC(i))
+		case RECORD_SERVICE_IMAGE:
+			t, err = comm.NewRecordServiceImageTask(dingocli, config.GetDC(i))
 		case CLEAN_PRECHECK_ENVIRONMENT:
 			if config.GetDC(i).GetRole() == topology.ROLE_FS_MDS_CLI {
 				continue
@@ -241,6 +391,7 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			START_DINGODB_DISKANN,
 			START_MDSV2_CLI_CONTAINER,
 			START_DINGODB_EXECUTOR,
+			START_FS_CACHE,
 			START_DINGODB_PROXY,
 			START_DINGODB_WEB:
 			t, err = comm.NewStartServiceTask(dingocli, config.GetDC(i))
@@ -256,6 +407,12 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = comm.NewGetServiceStatusTask(dingocli, config.GetDC(i))
 		case CLEAN_SERVICE:
 			t, err = comm.NewCleanServiceTask(dingocli, config.GetDC(i))
+		case BACKUP_ETCD_DATA:
+			t, err = comm.NewBackupEtcdDataTask(dingocli, config.GetDC(i))
+		case BACKUP_MDS_META:
+			t, err = comm.NewBackupMdsMetaTask(dingocli, config.GetDC(i))
+		case RESTORE_MDS_META:
+			t, err = comm.NewRestoreMdsMetaTask(dingocli, config.GetDC(i))
 		case INIT_CLIENT_STATUS:
 			t, err = comm.NewInitClientStatusTask(dingocli, config.GetAny(i))
 		case GET_CLIENT_STATUS:
@@ -306,6 +463,11 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 		if config.GetType() == TYPE_CONFIG_DEPLOY { // merge task status into one
 			t.SetTid(config.GetDC(i).GetId())
 			t.SetPtid(config.GetDC(i).GetParentId())
+			t.SetServiceId(config.GetDC(i).GetId())
+			t.SetRole(config.GetDC(i).GetRole())
+		}
+		if recorder := dingocli.Recorder(); recorder != nil {
+			t.SetRecorder(recorder)
 		}
 		ts.AddTask(t)
 	}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package playbook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStepKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	// every step type has its own key
+	keys := map[string]int{}
+	for stepType := CHECK_TOPOLOGY; stepType <= UNKNOWN; stepType++ {
+		key, ok := STEP_KEYS[stepType]
+		assert.True(ok, "step type %d has no key", stepType)
+		_, exist := keys[key]
+		assert.False(exist, "duplicate step key %s", key)
+		keys[key] = stepType
+	}
+
+	assert.Equal("start_fs_cache", StepKey(START_FS_CACHE))
+	assert.Equal("unknown_-1", StepKey(-1))
+}
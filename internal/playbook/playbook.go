@@ -17,8 +17,12 @@
 package playbook
 
 import (
+	"time"
+
 	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/tasks"
+	"github.com/fatih/color"
 )
 
 /*
@@ -48,9 +52,10 @@ type (
 	}
 
 	Playbook struct {
-		dingocli  *cli.DingoCli
-		steps     []*PlaybookStep
-		postSteps []*PlaybookStep
+		dingocli   *cli.DingoCli
+		steps      []*PlaybookStep
+		postSteps  []*PlaybookStep
+		checkpoint *checkpoint
 	}
 
 	ExecOptions = tasks.ExecOptions
@@ -71,34 +76,89 @@ func (p *Playbook) AddPostStep(s *PlaybookStep) {
 	p.postSteps = append(p.postSteps, s)
 }
 
-func (p *Playbook) run(steps []*PlaybookStep) error {
+func (p *Playbook) run(steps []*PlaybookStep, cp *checkpoint) error {
 	for i, step := range steps {
 		tasks, err := p.createTasks(step)
 		if err != nil {
 			return err
 		}
 
-		err = tasks.Execute(step.ExecOptions)
+		name, startTime := tasks.Name(), time.Now()
+		if len(name) == 0 {
+			name = step.Name
+		}
+		if cp != nil {
+			skipped := cp.track(i, step, tasks)
+			if skipped > 0 && !step.ExecOptions.SilentMainBar {
+				p.dingocli.WriteOutln(color.YellowString("%s: %d tasks skipped which succeeded in run %d",
+					name, skipped, cp.runId))
+			}
+			cp.setStep(i, step, name, comm.PLAYBOOK_STATUS_RUNNING, startTime, time.Time{})
+		}
+
+		options := p.execOptions(step, name)
+		if p.dingocli.DryRun() {
+			// the plan is printed after all steps recorded
+			options.SilentMainBar = true
+			options.SilentSubBar = true
+		}
+		err = tasks.Execute(options)
+		if cp != nil {
+			status := comm.PLAYBOOK_STATUS_SUCCESS
+			if err != nil {
+				status = comm.PLAYBOOK_STATUS_FAIL
+			}
+			cp.setStep(i, step, name, status, startTime, time.Now())
+		}
 		if err != nil && step.Type != CHECK_PORT_IN_USE {
 			return err
 		}
 
 		isLast := (i == len(steps)-1)
-		if !step.ExecOptions.SilentMainBar && !isLast {
+		if !options.SilentMainBar && !isLast {
 			p.dingocli.WriteOutln("")
 		}
 	}
 	return nil
 }
 
+// execOptions applies the concurrency configured in dingo.yaml or by flags
+func (p *Playbook) execOptions(step *PlaybookStep, name string) tasks.ExecOptions {
+	options := step.ExecOptions
+	concurrency := p.dingocli.Concurrency()
+	if n := concurrency.GetStepConcurrency(name); n > 0 {
+		options.Concurrency = n
+	} else if options.Concurrency == 0 {
+		options.Concurrency = concurrency.GetDefaultConcurrency()
+	}
+	options.HostConcurrency = concurrency.GetHostConcurrency()
+	options.RoleConcurrency = concurrency.GetRoleConcurrency()
+	return options
+}
+
 func (p *Playbook) Run() error {
+	if err := p.dingocli.LoadConcurrency(); err != nil {
+		return err
+	}
+
 	defer func() {
 		if len(p.postSteps) == 0 {
 			return
 		}
 		p.dingocli.WriteOutln("")
-		p.run(p.postSteps)
+		p.run(p.postSteps, nil)
 	}()
 
-	return p.run(p.steps)
+	// nothing is really executed in dry-run, so needn't persist
+	cp := p.checkpoint
+	if cp == nil || p.dingocli.DryRun() {
+		return p.run(p.steps, nil)
+	}
+
+	if err := cp.begin(p.steps); err != nil {
+		return err
+	}
+	err := p.run(p.steps, cp)
+	cp.end(err)
+	return err
 }
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/errno"
@@ -39,6 +40,17 @@ func GetMDSList(cmd *cobra.Command) ([]*mds.MDS, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	return getMDSList(mdsRpc)
+}
+
+// get mds list from the specified mds endpoints without command flags, e.g. cluster_mds_addr of topology
+func GetMDSListWithEndPoint(endpoints []string, timeout time.Duration) ([]*mds.MDS, error) {
+	mdsRpc := NewRpc(endpoints, timeout, utils.DEFAULT_RPCRETRYTIMES, utils.DEFAULT_RPCRETRYDELAY, false, "GetMDSList")
+	return getMDSList(mdsRpc)
+}
+
+func getMDSList(mdsRpc *Rpc) ([]*mds.MDS, error) {
 	getMDSRpc := &GetMDSRpc{
 		Info:    mdsRpc,
 		Request: &mds.GetMDSListRequest{},
@@ -105,6 +117,16 @@ func ListFsInfo(cmd *cobra.Command) ([]*mds.FsInfo, error) {
 	if err != nil {
 		return nil, err
 	}
+	return listFsInfo(mdsRpc)
+}
+
+// list fs info from the specified mds endpoints without command flags, e.g. cluster_mds_addr of topology
+func ListFsInfoWithEndPoint(endpoints []string, timeout time.Duration) ([]*mds.FsInfo, error) {
+	mdsRpc := NewRpc(endpoints, timeout, utils.DEFAULT_RPCRETRYTIMES, utils.DEFAULT_RPCRETRYDELAY, false, "ListFsInfo")
+	return listFsInfo(mdsRpc)
+}
+
+func listFsInfo(mdsRpc *Rpc) ([]*mds.FsInfo, error) {
 	// set request info
 	listFsRpc := &ListFsInfoRpc{Info: mdsRpc, Request: &mds.ListFsInfoRequest{}}
 	// get rpc result
@@ -480,3 +502,123 @@ func GetDirectorySizeAndInodes(cmd *cobra.Command, fsId uint32, dirInode uint64,
 	atomic.AddUint64(&summary.Inodes, 1)
 	return int64(summary.Length), int64(summary.Inodes), nil
 }
+
+// list cache members, all groups if group is empty
+func ListCacheMembers(cmd *cobra.Command, group string) ([]*mds.CacheGroupMember, error) {
+	// new rpc
+	mdsRpc, err := CreateNewMdsRpc(cmd, "ListMembers")
+	if err != nil {
+		return nil, err
+	}
+	return listCacheMembers(mdsRpc, group)
+}
+
+// list cache members from the specified mds endpoints without command flags, e.g. cluster_mds_addr of topology
+func ListCacheMembersWithEndPoint(endpoints []string, timeout time.Duration, group string) ([]*mds.CacheGroupMember, error) {
+	mdsRpc := NewRpc(endpoints, timeout, utils.DEFAULT_RPCRETRYTIMES, utils.DEFAULT_RPCRETRYDELAY, false, "ListMembers")
+	return listCacheMembers(mdsRpc, group)
+}
+
+func listCacheMembers(mdsRpc *Rpc, group string) ([]*mds.CacheGroupMember, error) {
+	// set request info
+	request := &mds.ListMembersRequest{}
+	if len(group) != 0 {
+		request.GroupName = &group
+	}
+	listRpc := &ListCacheMemberRpc{Info: mdsRpc, Request: request}
+	// get rpc result
+	response, rpcError := GetRpcResponse(listRpc.Info, listRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+
+	result := response.(*mds.ListMembersResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	return result.GetMembers(), nil
+}
+
+// get cache member by member id
+func GetCacheMember(cmd *cobra.Command, memberId string) (*mds.CacheGroupMember, error) {
+	members, err := ListCacheMembers(cmd, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if member.GetMemberId() == memberId {
+			return member, nil
+		}
+	}
+	return nil, errno.ERR_CACHE_MEMBER_NOT_FOUND.F("memberid: %s", memberId)
+}
+
+// set the weight of cache member
+func ReweightCacheMember(cmd *cobra.Command, memberId, ip string, port, weight uint32) error {
+	// new rpc
+	mdsRpc, err := CreateNewMdsRpc(cmd, "ReWeightMember")
+	if err != nil {
+		return err
+	}
+	// set request info
+	reWeightRpc := &ReWeightMemberRpc{
+		Info: mdsRpc,
+		Request: &mds.ReweightMemberRequest{
+			MemberId: memberId,
+			Ip:       ip,
+			Port:     port,
+			Weight:   weight,
+		},
+	}
+	// get rpc result
+	response, rpcError := GetRpcResponse(reWeightRpc.Info, reWeightRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return rpcError
+	}
+
+	result := response.(*mds.ReweightMemberResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	return nil
+}
+
+// leave cache member from group
+func LeaveCacheMember(cmd *cobra.Command, group, memberId, ip string, port uint32) error {
+	// new rpc
+	mdsRpc, err := CreateNewMdsRpc(cmd, "LeaveCacheMember")
+	if err != nil {
+		return err
+	}
+	return leaveCacheMember(mdsRpc, group, memberId, ip, port)
+}
+
+// leave cache member from group by the specified mds endpoints without command flags
+func LeaveCacheMemberWithEndPoint(endpoints []string, timeout time.Duration, group, memberId, ip string, port uint32) error {
+	mdsRpc := NewRpc(endpoints, timeout, utils.DEFAULT_RPCRETRYTIMES, utils.DEFAULT_RPCRETRYDELAY, false, "LeaveCacheMember")
+	return leaveCacheMember(mdsRpc, group, memberId, ip, port)
+}
+
+func leaveCacheMember(mdsRpc *Rpc, group, memberId, ip string, port uint32) error {
+	// set request info
+	leaveRpc := &LeaveCacheMemberRpc{
+		Info: mdsRpc,
+		Request: &mds.LeaveCacheGroupRequest{
+			GroupName: group,
+			MemberId:  memberId,
+			Ip:        ip,
+			Port:      port,
+		},
+	}
+	// get rpc result
+	response, rpcError := GetRpcResponse(leaveRpc.Info, leaveRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return rpcError
+	}
+
+	result := response.(*mds.LeaveCacheGroupResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	return nil
+}
@@ -325,6 +325,169 @@ var (
 	DeleteAnyItem = `DELETE from any WHERE id = ?`
 )
 
+// warmup profile
+type WarmupProfile struct {
+	Name             string
+	Data             string
+	CreateTime       time.Time
+	LastModifiedTime time.Time
+}
+
+var (
+	// table: warmup_profiles
+	CreateWarmupProfilesTable = `
+		CREATE TABLE IF NOT EXISTS warmup_profiles (
+			name TEXT PRIMARY KEY,
+			data TEXT NOT NULL,
+			create_time DATE NOT NULL,
+			lastmodified_time DATE NOT NULL
+		)
+	`
+
+	// insert warmup profile
+	InsertWarmupProfile = `
+		INSERT INTO warmup_profiles(name, data, create_time, lastmodified_time)
+		VALUES(?, ?, datetime('now','localtime'), datetime('now','localtime'))
+	`
+
+	// set warmup profile
+	SetWarmupProfile = `UPDATE warmup_profiles SET data = ?, lastmodified_time = datetime('now','localtime') WHERE name = ?`
+
+	// select warmup profiles
+	SelectWarmupProfiles = `SELECT * FROM warmup_profiles`
+
+	// select warmup profile by name
+	SelectWarmupProfileByName = `SELECT * FROM warmup_profiles WHERE name = ?`
+
+	// delete warmup profile
+	DeleteWarmupProfile = `DELETE from warmup_profiles WHERE name = ?`
+)
+
+// playbook run
+type PlaybookRun struct {
+	Id           int64
+	ClusterId    int
+	AuditId      int64
+	Operation    string
+	TopologyHash string
+	Status       int
+	CreateTime   time.Time
+	EndTime      time.Time
+}
+
+type PlaybookStep struct {
+	RunId     int64
+	StepIndex int
+	Key       string
+	Name      string
+	Status    int
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type PlaybookTask struct {
+	RunId     int64
+	StepIndex int
+	TaskKey   string
+	ServiceId string
+	Host      string
+	Status    int
+	StartTime time.Time
+	EndTime   time.Time
+	Error     string
+	Stdout    string
+	Stderr    string
+}
+
+var (
+	// table: playbook_runs
+	CreatePlaybookRunsTable = `
+		CREATE TABLE IF NOT EXISTS playbook_runs (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			cluster_id INTEGER NOT NULL,
+			audit_id INTEGER DEFAULT -1,
+			operation TEXT NOT NULL,
+			topology_hash TEXT NOT NULL,
+			status INTEGER DEFAULT 0,
+			create_time DATE NOT NULL,
+			end_time DATE NOT NULL
+		)
+	`
+
+	// table: playbook_steps
+	CreatePlaybookStepsTable = `
+		CREATE TABLE IF NOT EXISTS playbook_steps (
+			run_id INTEGER NOT NULL,
+			step_index INTEGER NOT NULL,
+			step_key TEXT NOT NULL,
+			name TEXT NOT NULL,
+			status INTEGER DEFAULT 0,
+			start_time DATE NOT NULL,
+			end_time DATE NOT NULL,
+			PRIMARY KEY(run_id, step_index)
+		)
+	`
+
+	// table: playbook_tasks
+	CreatePlaybookTasksTable = `
+		CREATE TABLE IF NOT EXISTS playbook_tasks (
+			run_id INTEGER NOT NULL,
+			step_index INTEGER NOT NULL,
+			task_key TEXT NOT NULL,
+			service_id TEXT NOT NULL,
+			host TEXT NOT NULL,
+			status INTEGER DEFAULT 0,
+			start_time DATE NOT NULL,
+			end_time DATE NOT NULL,
+			error TEXT NOT NULL,
+			stdout TEXT NOT NULL,
+			stderr TEXT NOT NULL,
+			PRIMARY KEY(run_id, step_index, task_key)
+		)
+	`
+
+	// insert playbook run
+	InsertPlaybookRun = `
+		INSERT INTO playbook_runs(cluster_id, audit_id, operation, topology_hash, status, create_time, end_time)
+		VALUES(?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// set playbook run status
+	SetPlaybookRunStatus = `UPDATE playbook_runs SET status = ?, end_time = ? WHERE id = ?`
+
+	// select playbook runs
+	SelectPlaybookRuns = `SELECT * FROM playbook_runs ORDER BY id`
+
+	// select playbook run by id
+	SelectPlaybookRunById = `SELECT * FROM playbook_runs WHERE id = ?`
+
+	// select last playbook run of operation
+	SelectLastPlaybookRun = `
+		SELECT * FROM playbook_runs
+		WHERE cluster_id = ? AND operation = ?
+		ORDER BY id DESC LIMIT 1
+	`
+
+	// replace playbook step
+	ReplacePlaybookStep = `
+		REPLACE INTO playbook_steps(run_id, step_index, step_key, name, status, start_time, end_time)
+		VALUES(?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// select playbook steps
+	SelectPlaybookSteps = `SELECT * FROM playbook_steps WHERE run_id = ? ORDER BY step_index`
+
+	// replace playbook task
+	ReplacePlaybookTask = `
+		REPLACE INTO playbook_tasks(run_id, step_index, task_key, service_id, host, status,
+		                            start_time, end_time, error, stdout, stderr)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// select playbook tasks
+	SelectPlaybookTasks = `SELECT * FROM playbook_tasks WHERE run_id = ? ORDER BY step_index`
+)
+
 var (
 	// check pool column
 	CheckPoolColumn = `
@@ -39,7 +39,8 @@ type Monitor struct {
 }
 
 type Storage struct {
-	db driver.IDataBaseDriver
+	db       driver.IDataBaseDriver
+	readOnly bool
 }
 
 func NewStorage(dbURL string) (*Storage, error) {
@@ -74,6 +75,10 @@ func (s *Storage) init() error {
 		CreateAuditTable,
 		CreateMonitorTable,
 		CreateAnyTable,
+		CreateWarmupProfilesTable,
+		CreatePlaybookRunsTable,
+		CreatePlaybookStepsTable,
+		CreatePlaybookTasksTable,
 	}
 
 	for _, sql := range sqls {
@@ -86,7 +91,15 @@ func (s *Storage) init() error {
 	return nil
 }
 
+// SetReadOnly makes all writes no-op and always succeed, e.g. for dry-run
+func (s *Storage) SetReadOnly(readOnly bool) {
+	s.readOnly = readOnly
+}
+
 func (s *Storage) write(query string, args ...any) error {
+	if s.readOnly {
+		return nil
+	}
 	_, err := s.db.Write(query, args...)
 	return err
 }
@@ -287,7 +300,7 @@ func (s *Storage) InsertClient(id, kind, host, containerId, auxInfo string) erro
 }
 
 func (s *Storage) SetClientAuxInfo(id, auxInfo string) error {
-	return s.write(SetClientAuxInfo, id, auxInfo)
+	return s.write(SetClientAuxInfo, auxInfo, id)
 }
 
 func (s *Storage) getClients(query string, args ...interface{}) ([]Client, error) {
@@ -425,9 +438,175 @@ func (s *Storage) GetAuditLog(id int64) ([]AuditLog, error) {
 	return s.getAuditLogs(SelectAuditLogById, id)
 }
 
+// warmup profile
+func (s *Storage) InsertWarmupProfile(name, data string) error {
+	return s.write(InsertWarmupProfile, name, data)
+}
+
+func (s *Storage) SetWarmupProfile(name, data string) error {
+	return s.write(SetWarmupProfile, data, name)
+}
+
+func (s *Storage) DeleteWarmupProfile(name string) error {
+	return s.write(DeleteWarmupProfile, name)
+}
+
+func (s *Storage) getWarmupProfiles(query string, args ...interface{}) ([]WarmupProfile, error) {
+	result, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	profiles := []WarmupProfile{}
+	var profile WarmupProfile
+	for result.Next() {
+		err = result.Scan(&profile.Name,
+			&profile.Data,
+			&profile.CreateTime,
+			&profile.LastModifiedTime)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+func (s *Storage) GetWarmupProfiles() ([]WarmupProfile, error) {
+	return s.getWarmupProfiles(SelectWarmupProfiles)
+}
+
+func (s *Storage) GetWarmupProfile(name string) ([]WarmupProfile, error) {
+	return s.getWarmupProfiles(SelectWarmupProfileByName, name)
+}
+
+// playbook run
+func (s *Storage) InsertPlaybookRun(run PlaybookRun) (int64, error) {
+	result, err := s.db.Write(InsertPlaybookRun, run.ClusterId, run.AuditId, run.Operation,
+		run.TopologyHash, run.Status, run.CreateTime, run.EndTime)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.LastInsertId()
+}
+
+func (s *Storage) SetPlaybookRunStatus(id int64, status int, endTime time.Time) error {
+	return s.write(SetPlaybookRunStatus, status, endTime, id)
+}
+
+func (s *Storage) getPlaybookRuns(query string, args ...interface{}) ([]PlaybookRun, error) {
+	result, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	runs := []PlaybookRun{}
+	var run PlaybookRun
+	for result.Next() {
+		err = result.Scan(&run.Id,
+			&run.ClusterId,
+			&run.AuditId,
+			&run.Operation,
+			&run.TopologyHash,
+			&run.Status,
+			&run.CreateTime,
+			&run.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+func (s *Storage) GetPlaybookRuns() ([]PlaybookRun, error) {
+	return s.getPlaybookRuns(SelectPlaybookRuns)
+}
+
+func (s *Storage) GetPlaybookRun(id int64) ([]PlaybookRun, error) {
+	return s.getPlaybookRuns(SelectPlaybookRunById, id)
+}
+
+func (s *Storage) GetLastPlaybookRun(clusterId int, operation string) ([]PlaybookRun, error) {
+	return s.getPlaybookRuns(SelectLastPlaybookRun, clusterId, operation)
+}
+
+func (s *Storage) SetPlaybookStep(step PlaybookStep) error {
+	return s.write(ReplacePlaybookStep, step.RunId, step.StepIndex, step.Key, step.Name,
+		step.Status, step.StartTime, step.EndTime)
+}
+
+func (s *Storage) GetPlaybookSteps(runId int64) ([]PlaybookStep, error) {
+	result, err := s.db.Query(SelectPlaybookSteps, runId)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	steps := []PlaybookStep{}
+	var step PlaybookStep
+	for result.Next() {
+		err = result.Scan(&step.RunId,
+			&step.StepIndex,
+			&step.Key,
+			&step.Name,
+			&step.Status,
+			&step.StartTime,
+			&step.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+func (s *Storage) SetPlaybookTask(task PlaybookTask) error {
+	return s.write(ReplacePlaybookTask, task.RunId, task.StepIndex, task.TaskKey, task.ServiceId,
+		task.Host, task.Status, task.StartTime, task.EndTime, task.Error, task.Stdout, task.Stderr)
+}
+
+func (s *Storage) GetPlaybookTasks(runId int64) ([]PlaybookTask, error) {
+	result, err := s.db.Query(SelectPlaybookTasks, runId)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	tasks := []PlaybookTask{}
+	var task PlaybookTask
+	for result.Next() {
+		err = result.Scan(&task.RunId,
+			&task.StepIndex,
+			&task.TaskKey,
+			&task.ServiceId,
+			&task.Host,
+			&task.Status,
+			&task.StartTime,
+			&task.EndTime,
+			&task.Error,
+			&task.Stdout,
+			&task.Stderr)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
 // any item prefix
 const (
-	PREFIX_CLIENT_CONFIG = 0x01
+	PREFIX_CLIENT_CONFIG      = 0x01
+	PREFIX_CACHE_MEMBER_DRAIN = 0x02
+	PREFIX_UPGRADE_RECORD     = 0x03
 )
 
 func (s *Storage) realId(prefix int, id string) string {
@@ -441,6 +620,10 @@ func (s *Storage) InsertClientConfig(id, data string) error {
 
 func (s *Storage) GetClientConfig(id string) ([]Any, error) {
 	id = s.realId(PREFIX_CLIENT_CONFIG, id)
+	return s.getAnyItems(id)
+}
+
+func (s *Storage) getAnyItems(id string) ([]Any, error) {
 	result, err := s.db.Query(SelectAnyItem, id)
 	if err != nil {
 		return nil, err
@@ -465,6 +648,50 @@ func (s *Storage) DeleteClientConfig(id string) error {
 	return s.write(DeleteAnyItem, id)
 }
 
+// the drain state of cache member, keyed by member id
+func (s *Storage) SetCacheMemberDrain(memberId, data string) error {
+	id := s.realId(PREFIX_CACHE_MEMBER_DRAIN, memberId)
+	items, err := s.getAnyItems(id)
+	if err != nil {
+		return err
+	} else if len(items) == 0 {
+		return s.write(InsertAnyItem, id, data)
+	}
+	return s.write(SetAnyItem, data, id)
+}
+
+func (s *Storage) GetCacheMemberDrain(memberId string) ([]Any, error) {
+	id := s.realId(PREFIX_CACHE_MEMBER_DRAIN, memberId)
+	return s.getAnyItems(id)
+}
+
+func (s *Storage) DeleteCacheMemberDrain(memberId string) error {
+	id := s.realId(PREFIX_CACHE_MEMBER_DRAIN, memberId)
+	return s.write(DeleteAnyItem, id)
+}
+
+// the image and container of service before upgrade, keyed by service id
+func (s *Storage) SetUpgradeRecord(serviceId, data string) error {
+	id := s.realId(PREFIX_UPGRADE_RECORD, serviceId)
+	items, err := s.getAnyItems(id)
+	if err != nil {
+		return err
+	} else if len(items) == 0 {
+		return s.write(InsertAnyItem, id, data)
+	}
+	return s.write(SetAnyItem, data, id)
+}
+
+func (s *Storage) GetUpgradeRecord(serviceId string) ([]Any, error) {
+	id := s.realId(PREFIX_UPGRADE_RECORD, serviceId)
+	return s.getAnyItems(id)
+}
+
+func (s *Storage) DeleteUpgradeRecord(serviceId string) error {
+	id := s.realId(PREFIX_UPGRADE_RECORD, serviceId)
+	return s.write(DeleteAnyItem, id)
+}
+
 func (s *Storage) GetMonitor(clusterId int) (Monitor, error) {
 	monitor := Monitor{
 		ClusterId: clusterId,
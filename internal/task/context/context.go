@@ -26,16 +26,26 @@ type Context struct {
 	register  *Register
 }
 
-func NewContext(sshClient *module.SSHClient) (*Context, error) {
+// NewContext returns a context whose module captures the output of executed commands
+func NewContext(sshClient *module.SSHClient, output *module.Output) (*Context, error) {
 	return &Context{
 		sshClient: sshClient,
-		module:    module.NewModule(sshClient),
+		module:    module.NewCaptureModule(sshClient, output),
 		register:  NewRegister(),
 	}, nil
 }
 
+// NewRecordContext returns a context whose module records commands instead of executing them
+func NewRecordContext(sshClient *module.SSHClient, recorder *module.TaskRecorder) *Context {
+	return &Context{
+		sshClient: sshClient,
+		module:    module.NewRecordModule(sshClient, recorder),
+		register:  NewRegister(),
+	}
+}
+
 func (ctx *Context) Close() {
-	if ctx.sshClient != nil {
+	if ctx.sshClient != nil && ctx.sshClient.Client() != nil {
 		ctx.sshClient.Client().Close()
 	}
 }
@@ -112,6 +112,7 @@ type (
 	ContainerExec struct {
 		ContainerId *string
 		Command     string
+		User        string
 		Success     *bool
 		Out         *string
 		module.ExecOptions
@@ -144,6 +145,7 @@ type (
 
 	ContainerLogs struct {
 		ContainerId string
+		Tail        int // <= 0 means all lines
 		Out         *string
 		Success     *bool
 		module.ExecOptions
@@ -163,6 +165,7 @@ func (s *PullImage) Execute(ctx *context.Context) error {
 }
 
 func (s *CreateContainer) Execute(ctx *context.Context) error {
+	engine := module.GetEngine(s.ExecWithEngine)
 	cli := ctx.Module().DockerCli().CreateContainer(s.Image, s.Command)
 	for _, host := range s.AddHost {
 		cli.AddOption("--add-host %s", host)
@@ -179,7 +182,7 @@ func (s *CreateContainer) Execute(ctx *context.Context) error {
 	if len(s.Hostname) > 0 {
 		cli.AddOption("--hostname %s", s.Hostname)
 	}
-	if s.Init {
+	if s.Init && engine.Init {
 		cli.AddOption("--init")
 	}
 	for _, capability := range s.LinuxCapabilities {
@@ -217,7 +220,7 @@ func (s *CreateContainer) Execute(ctx *context.Context) error {
 	for _, security := range s.SecurityOptions {
 		cli.AddOption("--security-opt %s", security)
 	}
-	for _, ulimit := range s.Ulimits {
+	for _, ulimit := range engine.Ulimits(s.Ulimits) {
 		cli.AddOption("--ulimit %s", ulimit)
 	}
 	for _, volume := range s.Volumes {
@@ -271,7 +274,7 @@ func (s *RemoveContainer) Execute(ctx *context.Context) error {
 func (s *ListContainers) Execute(ctx *context.Context) error {
 	cli := ctx.Module().DockerCli().ListContainers()
 	if len(s.Format) > 0 {
-		cli.AddOption("--format %s", s.Format)
+		cli.AddOption("--format %s", module.GetEngine(s.ExecWithEngine).Format(s.Format))
 	}
 	if len(s.Filter) > 0 {
 		cli.AddOption("--filter %s", s.Filter)
@@ -289,6 +292,9 @@ func (s *ListContainers) Execute(ctx *context.Context) error {
 
 func (s *ContainerExec) Execute(ctx *context.Context) error {
 	cli := ctx.Module().DockerCli().ContainerExec(*s.ContainerId, s.Command)
+	for _, flag := range module.GetEngine(s.ExecWithEngine).ExecFlags(false, false, s.User) {
+		cli.AddOption("%s", flag)
+	}
 	out, err := cli.Execute(s.ExecOptions)
 	// print out info
 	return PostHandle(s.Success, s.Out, out, err, errno.ERR_RUN_COMMAND_IN_CONTAINER_FAILED.FD("(%s exec CONTAINER COMMAND)", s.ExecWithEngine))
@@ -309,7 +315,7 @@ func (s *CopyIntoContainer) Execute(ctx *context.Context) error {
 func (s *InspectContainer) Execute(ctx *context.Context) error {
 	cli := ctx.Module().DockerCli().InspectContainer(s.ContainerId)
 	if len(s.Format) > 0 {
-		cli.AddOption("--format=%s", s.Format)
+		cli.AddOption("--format=%s", module.GetEngine(s.ExecWithEngine).Format(s.Format))
 	}
 
 	out, err := cli.Execute(s.ExecOptions)
@@ -318,6 +324,9 @@ func (s *InspectContainer) Execute(ctx *context.Context) error {
 
 func (s *ContainerLogs) Execute(ctx *context.Context) error {
 	cli := ctx.Module().DockerCli().ContainerLogs(s.ContainerId)
+	for _, flag := range module.GetEngine(s.ExecWithEngine).LogsFlags(s.Tail) {
+		cli.AddOption("%s", flag)
+	}
 	out, err := cli.Execute(s.ExecOptions)
 	return PostHandle(s.Success, s.Out, out, err, errno.ERR_GET_CONTAINER_LOGS_FAILED.FD("(%s logs ID)", s.ExecWithEngine))
 }
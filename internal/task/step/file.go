@@ -103,6 +103,15 @@ type (
 		module.ExecOptions
 	}
 
+	// upload local file to a temporary file first then rename it,
+	// so a running binary can be replaced
+	UploadFile struct {
+		LocalPath  string
+		RemotePath string
+		Mode       string
+		module.ExecOptions
+	}
+
 	CreateAndUploadDir struct {
 		HostDirName       string
 		ContainerDestId   *string
@@ -356,6 +365,29 @@ func (s *DownloadFile) Execute(ctx *context.Context) error {
 	return ctx.Module().File().Download(s.RemotePath, s.LocalPath)
 }
 
+func (s *UploadFile) Execute(ctx *context.Context) error {
+	remotePath := utils.RandFilename(TEMP_DIR)
+	err := ctx.Module().File().Upload(s.LocalPath, remotePath)
+	if err != nil {
+		return errno.ERR_UPLOAD_FILE_TO_REMOTE_BY_SSH_FAILED.E(err)
+	}
+
+	if len(s.Mode) > 0 {
+		cmd := ctx.Module().Shell().Chmod(s.Mode, remotePath)
+		_, err = cmd.Execute(s.ExecOptions)
+		if err != nil {
+			return errno.ERR_CHANGE_FILE_MODE_FAILED.E(err)
+		}
+	}
+
+	cmd := ctx.Module().Shell().Rename(remotePath, s.RemotePath)
+	_, err = cmd.Execute(s.ExecOptions)
+	if err != nil {
+		return errno.ERR_RENAME_FILE_OR_DIRECTORY_FAILED.E(err)
+	}
+	return nil
+}
+
 func (s *TrySyncFile) Execute(ctx *context.Context) error {
 	var input string
 	step := &ReadFile{
@@ -210,6 +210,16 @@ type (
 		module.ExecOptions
 	}
 
+	Systemctl struct {
+		Action  string // start, stop, restart, enable, disable, is-active, daemon-reload...
+		Units   []string
+		Now     bool // --now
+		Quiet   bool // --quiet
+		Success *bool
+		Out     *string
+		module.ExecOptions
+	}
+
 	// other
 	Hostname struct {
 		Success *bool
@@ -518,6 +528,19 @@ func (s *ModProbe) Execute(ctx *context.Context) error {
 	return PostHandle(s.Success, s.Out, out, err, errno.ERR_ADD_MODUDLE_FROM_LINUX_KERNEL_FAILED)
 }
 
+func (s *Systemctl) Execute(ctx *context.Context) error {
+	cmd := ctx.Module().Shell().Systemctl(s.Action, s.Units...)
+	if s.Now {
+		cmd.AddOption("--now")
+	}
+	if s.Quiet {
+		cmd.AddOption("--quiet")
+	}
+
+	out, err := cmd.Execute(s.ExecOptions)
+	return PostHandle(s.Success, s.Out, out, err, errno.ERR_CONTROL_SYSTEMD_UNIT_FAILED)
+}
+
 // other
 func (s *Hostname) Execute(ctx *context.Context) error {
 	cmd := ctx.Module().Shell().Command("hostname")
@@ -37,6 +37,8 @@ const (
 	LOG_DIR  = "log_dir"
 	DATA_DIR = "data_dir"
 	CORE_DIR = "core_dir"
+	// CACHE_DIR is the config key of cache directory
+	CACHE_DIR = "cache.dir"
 
 	ROLE_ETCD = topology.ROLE_ETCD
 	// ROLE_MDS_V1           = topology.ROLE_MDS_V1
@@ -44,6 +46,7 @@ const (
 	ROLE_SNAPSHOTCLONE    = topology.ROLE_SNAPSHOTCLONE
 	ROLE_METASERVER       = topology.ROLE_METASERVER
 	ROLE_FS_MDS           = topology.ROLE_FS_MDS
+	ROLE_FS_CACHE         = topology.ROLE_FS_CACHE
 	ROLE_COORDINATOR      = topology.ROLE_COORDINATOR
 	ROLE_STORE            = topology.ROLE_STORE
 	ROLE_DINGODB_DOCUMENT = topology.ROLE_DINGODB_DOCUMENT
@@ -61,6 +64,7 @@ var (
 		ROLE_CHUNKSERVER:   {ROLE_CHUNKSERVER, ROLE_FS_MDS},
 		ROLE_SNAPSHOTCLONE: {ROLE_SNAPSHOTCLONE},
 		ROLE_METASERVER:    {ROLE_METASERVER, ROLE_FS_MDS},
+		ROLE_FS_CACHE:      {ROLE_FS_MDS},
 	}
 )
 
@@ -70,6 +74,7 @@ var (
  * chunkserver -> { chunkserver, mds }
  * snapshotclone -> { snapshotclone }
  * metaserver -> { metaserver, mds }
+ * cache -> { mds }
  */
 func getServiceConnectAddress(from *topology.DeployConfig, dcs []*topology.DeployConfig) []Address {
 	m := map[string]bool{}
@@ -83,6 +88,8 @@ func getServiceConnectAddress(from *topology.DeployConfig, dcs []*topology.Deplo
 			continue
 		} else if _, ok := m[to.GetRole()]; !ok {
 			continue
+		} else if to.GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD {
+			continue // no mock http server for systemd service
 		}
 
 		address = append(address, getServiceListenAddresses(to)...)
@@ -220,6 +227,12 @@ func getServiceListenAddresses(dc *topology.DeployConfig) []Address {
 			IP:   dc.GetListenIp(),
 			Port: dc.GetDingoDBServerPort(),
 		})
+	case ROLE_FS_CACHE:
+		address = append(address, Address{
+			Role: ROLE_FS_CACHE,
+			IP:   dc.GetListenIp(),
+			Port: dc.GetListenPort(),
+		})
 
 	default:
 		// do nothing
@@ -247,6 +260,9 @@ func getServiceDirectorys(dc *topology.DeployConfig) []Directory {
 	if len(targetCoreDir) > 0 {
 		dirs = append(dirs, Directory{CORE_DIR, targetCoreDir})
 	}
+	if dc.GetRole() == ROLE_FS_CACHE && len(dc.GetCacheDir()) > 0 {
+		dirs = append(dirs, Directory{CACHE_DIR, dc.GetCacheDir()})
+	}
 
 	return dirs
 }
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package checker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	FORMAT_PODMAN_ROOTLESS = "'{{.Host.Security.Rootless}}'"
+)
+
+func checkEngineExist(host string, engine *module.Engine, success *bool, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if *success && len(strings.TrimSpace(*out)) > 0 {
+			return nil
+		}
+		return errno.ERR_CONTAINER_ENGINE_NOT_INSTALLED.
+			F("host=%s, engine=%s (%s not found in PATH)", host, engine.Name, engine.Binary)
+	}
+}
+
+func checkEngineRootless(host string, engine *module.Engine, success *bool, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if !*success {
+			return errno.ERR_GET_CONTAINER_ENGINE_INFO_FAILED.
+				F("host=%s, engine=%s\n%s", host, engine.Name, *out)
+		}
+
+		lines := strings.Split(strings.TrimSpace(engine.Trim(*out)), "\n")
+		if lines[len(lines)-1] != "true" {
+			return errno.ERR_CONTAINER_ENGINE_NOT_ROOTLESS.
+				F("host=%s, engine=%s", host, engine.Name)
+		}
+		return nil
+	}
+}
+
+// NewCheckContainerEngineTask checks the configured engine exists on host,
+// and the rootless engine really runs rootless for the ssh user
+func NewCheckContainerEngineTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	engine := module.GetEngine(dingocli.Engine())
+	subname := fmt.Sprintf("host=%s engine=%s", dc.GetHost(), engine.Name)
+	t := task.NewTask("Check Container Engine <engine>", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var out string
+	var success bool
+	options := dingocli.ExecOptions()
+	options.ExecWithSudo = false // `command` is a shell builtin
+
+	// (1) check engine command exist
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf("command -v %s", engine.Binary),
+		Success:     &success,
+		Out:         &out,
+		ExecOptions: options,
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: checkEngineExist(dc.GetHost(), engine, &success, &out),
+	})
+	// (2) check rootless engine runs without root
+	if engine.Rootless {
+		t.AddStep(&step.Command{
+			Command:     fmt.Sprintf("%s info --format %s", engine.Binary, FORMAT_PODMAN_ROOTLESS),
+			Success:     &success,
+			Out:         &out,
+			ExecOptions: options,
+		})
+		t.AddStep(&step.Lambda{
+			Lambda: checkEngineRootless(dc.GetHost(), engine, &success, &out),
+		})
+	}
+
+	return t, nil
+}
@@ -135,6 +135,24 @@ func NewCheckPortInUseTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*
 
 	var containerId, out string
 	var success bool
+	if dc.GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD {
+		// execute the "ss" command on host directly
+		for _, address := range addresses {
+			t.AddStep(&step.SocketStatistics{
+				Filter:      fmt.Sprintf(FORMAT_FILTER_SPORT, address.Port),
+				Listening:   true,
+				NoHeader:    true,
+				Success:     &success,
+				Out:         &out,
+				ExecOptions: dingocli.ExecOptions(),
+			})
+			t.AddStep(&step.Lambda{
+				Lambda: checkPortInUse(&success, &out, dc.GetHost(), address.Port),
+			})
+		}
+		return t, nil
+	}
+
 	t.AddStep(&step.PullImage{
 		Image:       dc.GetContainerImage(),
 		ExecOptions: dingocli.ExecOptions(),
@@ -249,6 +267,8 @@ func NewStartHTTPServerTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (
 	hc, err := dingocli.GetHost(dc.GetHost())
 	if err != nil {
 		return nil, err
+	} else if dc.GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD {
+		return nil, nil // mock http server runs in container
 	}
 
 	// add task
@@ -389,6 +409,8 @@ func NewCleanEnvironmentTask(dingocli *cli.DingoCli, dc *topology.DeployConfig)
 	hc, err := dingocli.GetHost(dc.GetHost())
 	if err != nil {
 		return nil, err
+	} else if dc.GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD {
+		return nil, nil // no precheck container created
 	}
 
 	// new task
@@ -157,6 +157,9 @@ func NewCheckPermissionTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (
 		})
 	}
 	// (4) check docker/podman engine command {exist, permission, running}
+	if dc.GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD {
+		return t, nil
+	}
 	t.AddStep(&step.EngineInfo{
 		Success:     &success,
 		Out:         &out,
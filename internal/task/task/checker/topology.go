@@ -113,6 +113,11 @@ func (s *step2CheckS3Configure) Execute(ctx *context.Context) error {
 
 func (s *step2CheckDirectoryPath) Execute(ctx *context.Context) error {
 	dc := s.dc
+	if dc.GetRole() == ROLE_FS_CACHE && len(dc.GetCacheDir()) == 0 {
+		return errno.ERR_CACHE_DIRECTORY_REQUIRED.
+			F("%s.host[%s].%s", dc.GetRole(), dc.GetHost(), CACHE_DIR)
+	}
+
 	dirs := getServiceDirectorys(dc)
 	for _, dir := range dirs {
 		if dir.Path == comm.SERVICE_DIR_ABSENT {
@@ -141,6 +146,21 @@ func (s *step2CheckDataDirectoryDuplicate) Execute(ctx *context.Context) error {
 		}
 		used[key] = true
 	}
+
+	// cache directory can't be shared by cache nodes on the same host
+	used = map[string]bool{}
+	for _, dc := range s.dcs {
+		if dc.GetRole() != ROLE_FS_CACHE || len(dc.GetCacheDir()) == 0 {
+			continue
+		}
+
+		key := fmt.Sprintf("%s:%s", dc.GetHost(), dc.GetCacheDir())
+		if _, ok := used[key]; ok {
+			return errno.ERR_CACHE_DIRECTORY_ALREADY_IN_USE.
+				F("%s.host[%s].%s: %s", dc.GetRole(), dc.GetHost(), CACHE_DIR, dc.GetCacheDir())
+		}
+		used[key] = true
+	}
 	return nil
 }
 
@@ -0,0 +1,368 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+const (
+	BACKUP_FILE_MDSMETA = "mds_meta.backup"
+	BACKUP_FILE_ETCD    = "etcd_snapshot.db"
+	BACKUP_TIME_FORMAT  = "2006-01-02 15:04:05"
+)
+
+// BackupManifest describes one backup which stored in the admin host,
+// it is saved as manifest.json beside the backup file
+type BackupManifest struct {
+	Id        string   `json:"id"`
+	Cluster   string   `json:"cluster"`
+	Kind      string   `json:"kind"`
+	Host      string   `json:"host"`
+	ServiceId string   `json:"service_id"`
+	Endpoints []string `json:"endpoints"`
+	File      string   `json:"file"`
+	Size      int64    `json:"size"`
+	Sha256    string   `json:"sha256"`
+	CreatedAt string   `json:"created_at"`
+}
+
+func ReadBackupManifest(dir string) (*BackupManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, comm.BACKUP_MANIFEST))
+	if err != nil {
+		return nil, errno.ERR_DECODE_BACKUP_MANIFEST_FAILED.E(err)
+	}
+	manifest := &BackupManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errno.ERR_DECODE_BACKUP_MANIFEST_FAILED.E(err)
+	}
+	return manifest, nil
+}
+
+// VerifyBackup checks the backup file against the checksum recorded in manifest
+func VerifyBackup(dir string, manifest *BackupManifest) error {
+	digest, err := component.FileSha256(filepath.Join(dir, manifest.File))
+	if err != nil {
+		return errno.ERR_BACKUP_NOT_FOUND.E(err)
+	} else if digest != manifest.Sha256 {
+		return errno.ERR_BACKUP_CHECKSUM_MISMATCH.
+			F("%s: expected %s, got %s", manifest.File, manifest.Sha256, digest)
+	}
+	return nil
+}
+
+// GetBackupKind returns which data the service role can backup,
+// mds-client backups the meta of mdsv2 and etcd backups the data of mdsv1
+func GetBackupKind(dc *topology.DeployConfig) string {
+	switch dc.GetRole() {
+	case topology.ROLE_FS_MDS_CLI:
+		return comm.BACKUP_KIND_MDSMETA
+	case topology.ROLE_ETCD:
+		return comm.BACKUP_KIND_ETCD
+	}
+	return ""
+}
+
+func getCoordinatorAddr(dc *topology.DeployConfig) string {
+	coordinatorAddr := dc.GetDingoStoreCoordinatorAddr()
+	if len(coordinatorAddr) == 1 {
+		coordinatorAddr, _ = dc.GetVariables().Get("coordinator_addr")
+	}
+	return coordinatorAddr
+}
+
+func getBackupEndpoints(dingocli *cli.DingoCli, dc *topology.DeployConfig) []string {
+	if GetBackupKind(dc) == comm.BACKUP_KIND_MDSMETA {
+		return strings.Split(getCoordinatorAddr(dc), ",")
+	}
+
+	endpoints := []string{}
+	dcs, ok := dingocli.MemStorage().Get(comm.KEY_ALL_DEPLOY_CONFIGS).([]*topology.DeployConfig)
+	if !ok {
+		dcs = []*topology.DeployConfig{dc}
+	}
+	for _, dc := range dcs {
+		if dc.GetRole() == topology.ROLE_ETCD {
+			endpoints = append(endpoints, fmt.Sprintf("%s:%d", dc.GetListenIp(), dc.GetListenClientPort()))
+		}
+	}
+	return endpoints
+}
+
+func getMdsMetaCommand(dc *topology.DeployConfig, cmd, file string) string {
+	binary := dc.GetProjectLayout().FSMdsCliBinaryPath
+	if isSystemd(dc) {
+		binary = systemdBinaryPath(dc)
+	}
+	option := fmt.Sprintf("--output_type=file --out=%s", file)
+	if cmd == "restore" {
+		option = fmt.Sprintf("--input_type=file --in=%s", file)
+	}
+	return fmt.Sprintf("%s --cmd=%s --type=meta --coor_addr=list://%s --cluster_id=%d %s",
+		binary, cmd, getCoordinatorAddr(dc), dc.GetDingoClusterId(), option)
+}
+
+// etcdctl only saves snapshot from one endpoint, so we use the member which in this container
+func getEtcdSnapshotCommand(dc *topology.DeployConfig, file string) string {
+	return fmt.Sprintf("env ETCDCTL_API=3 %s/etcdctl --endpoints=%s:%d snapshot save %s",
+		dc.GetProjectLayout().ServiceBinDir, dc.GetListenIp(), dc.GetListenClientPort(), file)
+}
+
+func checkBackupContainer(dc *topology.DeployConfig, containerId string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if containerId == comm.CLEANED_CONTAINER_ID {
+			return errno.ERR_CONTAINER_ALREADT_REMOVED.
+				F("host=%s role=%s", dc.GetHost(), dc.GetRole())
+		}
+		return nil
+	}
+}
+
+func checkBackupSuccess(success *bool, out *string, code *errno.ErrorCode) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if !*success {
+			return code.S(*out)
+		}
+		return nil
+	}
+}
+
+func writeBackupManifest(dingocli *cli.DingoCli, dc *topology.DeployConfig,
+	serviceId, dir, file string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		localPath := filepath.Join(dir, file)
+		info, err := os.Stat(localPath)
+		if err != nil {
+			return errno.ERR_WRITE_BACKUP_MANIFEST_FAILED.E(err)
+		}
+		digest, err := component.FileSha256(localPath)
+		if err != nil {
+			return errno.ERR_WRITE_BACKUP_MANIFEST_FAILED.E(err)
+		}
+
+		manifest := BackupManifest{
+			Id:        filepath.Base(dir),
+			Cluster:   dingocli.ClusterName(),
+			Kind:      GetBackupKind(dc),
+			Host:      dc.GetHost(),
+			ServiceId: serviceId,
+			Endpoints: getBackupEndpoints(dingocli, dc),
+			File:      file,
+			Size:      info.Size(),
+			Sha256:    digest,
+			CreatedAt: time.Now().Format(BACKUP_TIME_FORMAT),
+		}
+		data, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return errno.ERR_WRITE_BACKUP_MANIFEST_FAILED.E(err)
+		}
+		err = os.WriteFile(filepath.Join(dir, comm.BACKUP_MANIFEST), data, 0644)
+		if err != nil {
+			return errno.ERR_WRITE_BACKUP_MANIFEST_FAILED.E(err)
+		}
+		return nil
+	}
+}
+
+func newBackupTask(dingocli *cli.DingoCli, dc *topology.DeployConfig, name string) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if err != nil && !isSystemd(dc) {
+		return nil, err
+	}
+	dir := dingocli.MemStorage().Get(comm.KEY_BACKUP_DIR).(string)
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s", dc.GetHost(), dc.GetRole())
+	if !isSystemd(dc) {
+		subname = fmt.Sprintf("%s containerId=%s", subname, tui.TrimContainerId(containerId))
+	}
+	t := task.NewTask(name, subname, hc.GetSSHConfig())
+
+	// add step to task
+	var success bool
+	var out string
+	file, code := BACKUP_FILE_MDSMETA, errno.ERR_BACKUP_MDS_META_FAILED
+	if GetBackupKind(dc) == comm.BACKUP_KIND_ETCD {
+		file, code = BACKUP_FILE_ETCD, errno.ERR_BACKUP_ETCD_DATA_FAILED
+	}
+	command := func(path string) string {
+		if GetBackupKind(dc) == comm.BACKUP_KIND_ETCD {
+			return getEtcdSnapshotCommand(dc, path)
+		}
+		return getMdsMetaCommand(dc, "backup", path)
+	}
+	// the temporary files are removed even if the backup failed, the one on
+	// host first as the container may be not running
+	hostPath := utils.RandFilename(step.TEMP_DIR)
+	t.AddPostStep(&step.RemoveFile{
+		Files:       []string{hostPath},
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	if isSystemd(dc) {
+		t.AddStep(&step.Command{ // backup on host directly
+			Command:     command(hostPath),
+			Success:     &success,
+			Out:         &out,
+			ExecOptions: dingocli.ExecOptions(),
+		})
+		t.AddStep(&step.Lambda{
+			Lambda: checkBackupSuccess(&success, &out, code),
+		})
+	} else {
+		containerPath := utils.RandFilename(step.TEMP_DIR)
+		t.AddStep(&step.Lambda{
+			Lambda: checkBackupContainer(dc, containerId),
+		})
+		t.AddStep(&step.ContainerExec{
+			ContainerId: &containerId,
+			Command:     command(containerPath),
+			Success:     &success,
+			Out:         &out,
+			ExecOptions: dingocli.ExecOptions(),
+		})
+		t.AddStep(&step.Lambda{
+			Lambda: checkBackupSuccess(&success, &out, code),
+		})
+		t.AddStep(&step.CopyFromContainer{
+			ContainerId:      containerId,
+			ContainerSrcPath: containerPath,
+			HostDestPath:     hostPath,
+			ExecOptions:      dingocli.ExecOptions(),
+		})
+		t.AddPostStep(&step.ContainerExec{
+			ContainerId: &containerId,
+			Command:     fmt.Sprintf("rm -f %s", containerPath),
+			ExecOptions: dingocli.ExecOptions(),
+		})
+	}
+	t.AddStep(&step.DownloadFile{
+		RemotePath:  hostPath,
+		LocalPath:   filepath.Join(dir, file),
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: writeBackupManifest(dingocli, dc, serviceId, dir, file),
+	})
+
+	return t, nil
+}
+
+// NewBackupMdsMetaTask backups the meta of mdsv2 through dingo-mds-client
+func NewBackupMdsMetaTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	return newBackupTask(dingocli, dc, "Backup MDS Meta")
+}
+
+// NewBackupEtcdDataTask saves the snapshot of etcd which stores the meta of mdsv1
+func NewBackupEtcdDataTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	return newBackupTask(dingocli, dc, "Backup Etcd Data")
+}
+
+// NewRestoreMdsMetaTask restores the meta of mdsv2 from backup which downloaded by backup task
+func NewRestoreMdsMetaTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if err != nil && !isSystemd(dc) {
+		return nil, err
+	}
+	localPath := dingocli.MemStorage().Get(comm.KEY_RESTORE_FILE).(string)
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s", dc.GetHost(), dc.GetRole())
+	if !isSystemd(dc) {
+		subname = fmt.Sprintf("%s containerId=%s", subname, tui.TrimContainerId(containerId))
+	}
+	t := task.NewTask("Restore MDS Meta", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var success bool
+	var out string
+	// the temporary files are removed even if the restore failed
+	hostPath := utils.RandFilename(step.TEMP_DIR)
+	t.AddPostStep(&step.RemoveFile{
+		Files:       []string{hostPath},
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.UploadFile{
+		LocalPath:   localPath,
+		RemotePath:  hostPath,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	if isSystemd(dc) {
+		t.AddStep(&step.Command{ // restore on host directly
+			Command:     getMdsMetaCommand(dc, "restore", hostPath),
+			Success:     &success,
+			Out:         &out,
+			ExecOptions: dingocli.ExecOptions(),
+		})
+		t.AddStep(&step.Lambda{
+			Lambda: checkBackupSuccess(&success, &out, errno.ERR_RESTORE_MDS_META_FAILED),
+		})
+	} else {
+		containerPath := utils.RandFilename(step.TEMP_DIR)
+		t.AddStep(&step.Lambda{
+			Lambda: checkBackupContainer(dc, containerId),
+		})
+		t.AddStep(&step.CopyIntoContainer{
+			HostSrcPath:       hostPath,
+			ContainerId:       containerId,
+			ContainerDestPath: containerPath,
+			ExecOptions:       dingocli.ExecOptions(),
+		})
+		t.AddStep(&step.ContainerExec{
+			ContainerId: &containerId,
+			Command:     getMdsMetaCommand(dc, "restore", containerPath),
+			Success:     &success,
+			Out:         &out,
+			ExecOptions: dingocli.ExecOptions(),
+		})
+		t.AddStep(&step.Lambda{
+			Lambda: checkBackupSuccess(&success, &out, errno.ERR_RESTORE_MDS_META_FAILED),
+		})
+		t.AddPostStep(&step.ContainerExec{
+			ContainerId: &containerId,
+			Command:     fmt.Sprintf("rm -f %s", containerPath),
+			ExecOptions: dingocli.ExecOptions(),
+		})
+	}
+
+	return t, nil
+}
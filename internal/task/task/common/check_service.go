@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/task/context"
 	"github.com/dingodb/dingocli/internal/task/step"
 	"github.com/dingodb/dingocli/internal/task/task"
 	tui "github.com/dingodb/dingocli/internal/tui/common"
@@ -51,6 +53,13 @@ func NewCheckStoreHealthTask(dingocli *cli.DingoCli, dc *topology.DeployConfig)
 		Out:         &out,
 		ExecOptions: dingocli.ExecOptions(),
 	})
+	// record the result for callers which gate on it, e.g. rolling upgrade
+	t.AddStep(&step.Lambda{
+		Lambda: func(ctx *context.Context) error {
+			dingocli.MemStorage().Set(comm.KEY_STORE_HEALTH, success)
+			return nil
+		},
+	})
 
 	return t, nil
 }
@@ -119,6 +119,9 @@ func NewCleanServiceTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*ta
 			return nil, nil
 		}
 	}
+	if isSystemd(dc) {
+		return NewCleanSystemdServiceTask(dingocli, dc)
+	}
 
 	// new task
 	only := dingocli.MemStorage().Get(comm.KEY_CLEAN_ITEMS).([]string)
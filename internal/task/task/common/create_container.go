@@ -68,6 +68,15 @@ const (
 	ENV_DINGOFS_V2_INSTANCE_START_ID = "MDS_INSTANCE_START_ID"
 	ENV_DINGOFS_V2_CLUSTER_ID        = "CLUSTER_ID"
 
+	// dingofs cache
+	ENV_DINGOFS_CACHE_FLAGS_ID          = "FLAGS_id"
+	ENV_DINGOFS_CACHE_FLAGS_LISTEN_IP   = "FLAGS_listen_ip"
+	ENV_DINGOFS_CACHE_FLAGS_LISTEN_PORT = "FLAGS_listen_port"
+	ENV_DINGOFS_CACHE_FLAGS_GROUP_NAME  = "FLAGS_group_name"
+	ENV_DINGOFS_CACHE_FLAGS_CACHE_DIR   = "FLAGS_cache_dir"
+	ENV_DINGOFS_CACHE_FLAGS_CACHE_SIZE  = "FLAGS_cache_size_mb"
+	ENV_DINGOFS_CACHE_FLAGS_MDS_ADDRS   = "FLAGS_mds_addrs"
+
 	// dingodb executor
 	ENV_DINGODB_EXECUTOR_ROLE         = "DINGO_ROLE"
 	ENV_DINGODB_EXECUTOR_HOSTNAME     = "DINGO_HOSTNAME"
@@ -175,6 +184,8 @@ func GetEnvironments(dc *topology.DeployConfig) []string {
 			envs = configDingoStoreENV(envs, dc)
 		case topology.ROLE_DINGODB_EXECUTOR:
 			envs = configExecutorENV(envs, dc)
+		case topology.ROLE_FS_CACHE:
+			envs = configCacheENV(envs, dc)
 		default:
 			// just keep the old envs
 			preloads := []string{"/usr/local/lib/libjemalloc.so"}
@@ -235,11 +246,7 @@ func GetEnvironments(dc *topology.DeployConfig) []string {
 func configExecutorENV(envs []string, dc *topology.DeployConfig) []string {
 	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGODB_EXECUTOR_ROLE, dc.GetRole()))
 	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGODB_EXECUTOR_HOSTNAME, dc.GetHostname()))
-	coordinator_addr := dc.GetDingoStoreCoordinatorAddr()
-	if len(coordinator_addr) == 1 {
-		coordinator_addr, _ = dc.GetVariables().Get("coordinator_addr")
-	}
-	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGODB_EXECUTOR_COORDINATORS, coordinator_addr))
+	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGODB_EXECUTOR_COORDINATORS, getCoordinatorAddr(dc)))
 	return envs
 }
 
@@ -250,16 +257,29 @@ func configMdsv2ENV(envs []string, dc *topology.DeployConfig) []string {
 	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGO_SERVER_LISTEN_HOST, dc.GetDingoServerListenHost()))
 	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGO_SERVER_HOST, dc.GetHostname()))
 	envs = append(envs, fmt.Sprintf("%s=%d", ENV_DINGO_SERVER_START_PORT, dc.GetDingoServerPort()))
-	coordinator_addr := dc.GetDingoStoreCoordinatorAddr()
-	if len(coordinator_addr) == 1 {
-		coordinator_addr, _ = dc.GetVariables().Get("coordinator_addr")
-	}
-	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOSTORE_COORDINATOR_ADDR, coordinator_addr))
+	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOSTORE_COORDINATOR_ADDR, getCoordinatorAddr(dc)))
 	envs = append(envs, fmt.Sprintf("%s=%d", ENV_DINGOFS_V2_INSTANCE_START_ID, dc.GetDingoInstanceId()))
 	envs = append(envs, fmt.Sprintf("%s=%d", ENV_DINGOFS_V2_CLUSTER_ID, dc.GetDingoClusterId()))
 	return envs
 }
 
+// configCacheENV passes the gflags of dingo-cache, the node joins the cache
+// group with group_name by itself once started
+func configCacheENV(envs []string, dc *topology.DeployConfig) []string {
+	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOFS_V2_FLAGS_ROLE, topology.ROLE_FS_CACHE))
+	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOFS_CACHE_FLAGS_ID, dc.GetCacheMemberId()))
+	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOFS_CACHE_FLAGS_LISTEN_IP, dc.GetListenIp()))
+	envs = append(envs, fmt.Sprintf("%s=%d", ENV_DINGOFS_CACHE_FLAGS_LISTEN_PORT, dc.GetListenPort()))
+	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOFS_CACHE_FLAGS_GROUP_NAME, dc.GetCacheGroup()))
+	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOFS_CACHE_FLAGS_CACHE_DIR, dc.GetProjectLayout().ServiceCacheDir))
+	envs = append(envs, fmt.Sprintf("%s=%d", ENV_DINGOFS_CACHE_FLAGS_CACHE_SIZE, dc.GetCacheSize()))
+	mdsAddr, err := dc.GetVariables().Get(comm.KEY_ENV_MDS_ADDR)
+	if err == nil {
+		envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOFS_CACHE_FLAGS_MDS_ADDRS, mdsAddr))
+	}
+	return envs
+}
+
 func configDingoStoreENV(envs []string, dc *topology.DeployConfig) []string {
 	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOSTROE_FLAGS_ROLE, dc.GetRole()))
 	envs = append(envs, fmt.Sprintf("%s=%s", ENV_DINGOSTROE_FLAGS_CLEAN_LOG, "1")) // not clean log
@@ -372,6 +392,13 @@ func getMountVolumes(dc *topology.DeployConfig) []step.Volume {
 			ContainerPath: layout.DingoStoreVectorDir,
 		})
 	}
+	if dc.GetRole() == topology.ROLE_FS_CACHE {
+		// mount cache dir
+		volumes = append(volumes, step.Volume{
+			HostPath:      dc.GetCacheDir(),
+			ContainerPath: layout.ServiceCacheDir,
+		})
+	}
 
 	return volumes
 }
@@ -380,7 +407,7 @@ func getRestartPolicy(dc *topology.DeployConfig) string {
 	switch dc.GetRole() {
 	case topology.ROLE_ETCD:
 		return POLICY_ALWAYS_RESTART
-	case topology.ROLE_COORDINATOR, topology.ROLE_STORE, topology.ROLE_FS_MDS:
+	case topology.ROLE_COORDINATOR, topology.ROLE_STORE, topology.ROLE_FS_MDS, topology.ROLE_FS_CACHE:
 		if restartPolicy := dc.GetServiceConfig()["restart_policy"]; restartPolicy != "" {
 			return restartPolicy
 		}
@@ -400,6 +427,8 @@ func TrimContainerId(containerId *string) step.LambdaType {
 func NewCreateContainerTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
 	if dc.GetRole() == topology.ROLE_FS_MDS_CLI {
 		return nil, nil
+	} else if isSystemd(dc) {
+		return NewCreateSystemdServiceTask(dingocli, dc)
 	}
 	hc, err := dingocli.GetHost(dc.GetHost())
 	if err != nil {
@@ -440,6 +469,9 @@ func NewCreateContainerTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (
 	if dc.GetRole() == topology.ROLE_DINGODB_INDEX {
 		createDir = append(createDir, dc.GetDingoStoreVectorDir())
 	}
+	if dc.GetRole() == topology.ROLE_FS_CACHE {
+		createDir = append(createDir, dc.GetCacheDir())
+	}
 	// TODO createDir for dingodb executor /opt/dingo/localStore
 
 	t.AddStep(&step.CreateDirectory{
@@ -478,6 +510,9 @@ func NewCreateContainerTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (
 }
 
 func NewCreateMdsv2CliContainerTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	if isSystemd(dc) {
+		return NewCreateSystemdServiceTask(dingocli, dc)
+	}
 	hc, err := dingocli.GetHost(dc.GetHost())
 	if err != nil {
 		return nil, err
@@ -34,6 +34,8 @@ func NewCreateMetaTablesTask(dingocli *cli.DingoCli, dc *topology.DeployConfig)
 		return nil, nil
 	} else if err != nil {
 		return nil, err
+	} else if isSystemd(dc) {
+		return NewCreateSystemdMetaTablesTask(dingocli, dc)
 	}
 	hc, err := dingocli.GetHost(dc.GetHost())
 	if err != nil {
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+)
+
+const (
+	JOIN_CACHE_GROUP_RETRIES  = 30
+	JOIN_CACHE_GROUP_INTERVAL = 2 // seconds
+
+	// wait until the member with listen address shows in the cache group
+	CMD_WAIT_CACHE_MEMBER = "bash -c 'for i in $(seq 1 %d); do " +
+		"%s cache member list --group %s 2>/dev/null | grep -w %s | grep -qw %d && exit 0; " +
+		"sleep %d; done; exit 1'"
+)
+
+func checkJoinCacheGroupSuccess(dc *topology.DeployConfig, success *bool, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if !*success {
+			return errno.ERR_JOIN_CACHE_GROUP_FAILED.
+				F("host=%s group=%s member=%s:%d %s",
+					dc.GetHost(), dc.GetCacheGroup(), dc.GetListenIp(), dc.GetListenPort(), *out)
+		}
+		return nil
+	}
+}
+
+// NewJoinCacheGroupTask waits the started cache node joined its cache group
+func NewJoinCacheGroupTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	if isSystemd(dc) {
+		return nil, nil // no dingo tools on host, the node joins the group by itself
+	}
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if dingocli.IsSkip(dc) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s group=%s containerId=%s",
+		dc.GetHost(), dc.GetCacheGroup(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Join Cache Group", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var success bool
+	var out string
+	t.AddStep(&step.ListContainers{
+		ShowAll:     true,
+		Format:      `"{{.ID}}"`,
+		Filter:      fmt.Sprintf("id=%s", containerId),
+		Out:         &out,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: CheckContainerExist(dc.GetHost(), dc.GetRole(), containerId, &out),
+	})
+	t.AddStep(&step.ContainerExec{
+		ContainerId: &containerId,
+		Command: fmt.Sprintf(CMD_WAIT_CACHE_MEMBER, JOIN_CACHE_GROUP_RETRIES,
+			dc.GetProjectLayout().FSToolsBinaryPath, dc.GetCacheGroup(),
+			dc.GetListenIp(), dc.GetListenPort(), JOIN_CACHE_GROUP_INTERVAL),
+		Success:     &success,
+		Out:         &out,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: checkJoinCacheGroupSuccess(dc, &success, &out),
+	})
+
+	return t, nil
+}
@@ -26,6 +26,9 @@ import (
 )
 
 func NewPullImageTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	if isSystemd(dc) {
+		return NewInstallBinaryTask(dingocli, dc)
+	}
 	hc, err := dingocli.GetHost(dc.GetHost())
 	if err != nil {
 		return nil, err
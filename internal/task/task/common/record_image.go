@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+)
+
+// UpgradeRecord is the image and container of service before upgrade replaces them
+type UpgradeRecord struct {
+	ServiceId   string `json:"service_id"`
+	Image       string `json:"image"`
+	ContainerId string `json:"container_id"`
+	Time        string `json:"time"`
+}
+
+func GetUpgradeRecord(dingocli *cli.DingoCli, serviceId string) (*UpgradeRecord, error) {
+	items, err := dingocli.Storage().GetUpgradeRecord(serviceId)
+	if err != nil {
+		return nil, errno.ERR_SELECT_UPGRADE_RECORD_FAILED.E(err)
+	} else if len(items) == 0 {
+		return nil, nil
+	}
+
+	record := &UpgradeRecord{}
+	if err := json.Unmarshal([]byte(items[0].Data), record); err != nil {
+		return nil, errno.ERR_SELECT_UPGRADE_RECORD_FAILED.E(err)
+	}
+	return record, nil
+}
+
+func recordServiceImage(dingocli *cli.DingoCli, dc *topology.DeployConfig,
+	serviceId, containerId string, image *string, success *bool) step.LambdaType {
+	return func(ctx *context.Context) error {
+		current := strings.Trim(strings.TrimSpace(*image), `"`)
+		if !*success || len(current) == 0 {
+			return nil // container losed, nothing to rollback to
+		} else if current == dc.GetContainerImage() {
+			return nil // upgrade again with the same image, keep the record before it
+		}
+
+		data, err := json.Marshal(&UpgradeRecord{
+			ServiceId:   serviceId,
+			Image:       current,
+			ContainerId: containerId,
+			Time:        time.Now().Format("2006-01-02 15:04:05"),
+		})
+		if err != nil {
+			return errno.ERR_SET_UPGRADE_RECORD_FAILED.E(err)
+		}
+		if err := dingocli.Storage().SetUpgradeRecord(serviceId, string(data)); err != nil {
+			return errno.ERR_SET_UPGRADE_RECORD_FAILED.E(err)
+		}
+		return nil
+	}
+}
+
+// NewRecordServiceImageTask records the image of the running container for rollback
+func NewRecordServiceImageTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	if isSystemd(dc) {
+		return nil, nil // no image to rollback to
+	}
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if dingocli.IsSkip(dc) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	} else if containerId == comm.CLEANED_CONTAINER_ID {
+		return nil, nil
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Record Service Image", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var image string
+	var success bool
+	t.AddStep(&step.InspectContainer{
+		ContainerId: containerId,
+		Format:      `"{{.Config.Image}}"`,
+		Out:         &image,
+		Success:     &success,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: recordServiceImage(dingocli, dc, serviceId, containerId, &image, &success),
+	})
+
+	return t, nil
+}
@@ -48,6 +48,9 @@ func WaitContainerStart(seconds int) step.LambdaType {
 }
 
 func NewRestartServiceTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	if isSystemd(dc) {
+		return newSystemdServiceTask(dingocli, dc, "Restart Service", "restart")
+	}
 	serviceId := dingocli.GetServiceId(dc.GetId())
 	containerId, err := dingocli.GetContainerId(serviceId)
 	if dingocli.IsSkip(dc) {
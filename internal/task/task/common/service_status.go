@@ -264,6 +264,9 @@ func TrimContainerStatus(status *string) step.LambdaType {
 }
 
 func NewGetServiceStatusTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	if isSystemd(dc) {
+		return NewGetSystemdServiceStatusTask(dingocli, dc)
+	}
 	serviceId := dingocli.GetServiceId(dc.GetId())
 	containerId, err := dingocli.GetContainerId(serviceId)
 	if dingocli.IsSkip(dc) {
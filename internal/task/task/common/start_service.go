@@ -73,6 +73,9 @@ func NewStartServiceTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*ta
 			return nil, nil
 		}
 	}
+	if isSystemd(dc) {
+		return newSystemdServiceTask(dingocli, dc, "Start Service", "start")
+	}
 
 	serviceId := dingocli.GetServiceId(dc.GetId())
 	containerId, err := dingocli.GetContainerId(serviceId)
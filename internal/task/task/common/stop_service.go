@@ -61,6 +61,9 @@ func NewStopServiceTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*tas
 			return nil, nil
 		}
 	}
+	if isSystemd(dc) {
+		return newSystemdServiceTask(dingocli, dc, "Stop Service", "stop")
+	}
 
 	serviceId := dingocli.GetServiceId(dc.GetId())
 	containerId, err := dingocli.GetContainerId(serviceId)
@@ -120,6 +120,9 @@ func NewSyncConfigTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task
 			return nil, nil
 		}
 	}
+	if isSystemd(dc) {
+		return NewSyncSystemdConfigTask(dingocli, dc)
+	}
 
 	serviceId := dingocli.GetServiceId(dc.GetId())
 	containerId, err := dingocli.GetContainerId(serviceId)
@@ -0,0 +1,661 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/scripts"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	SYSTEMD_BINARY_DIR  = "/usr/local/dingofs/bin"
+	SYSTEMD_CONF_DIR    = "/etc/dingofs"
+	SYSTEMD_UNIT_DIR    = "/etc/systemd/system"
+	SYSTEMD_UNIT_PREFIX = "dingofs"
+	SYSTEMD_ENV_FILE    = "env"
+
+	SYSTEMD_STATE_ACTIVE  = "active"
+	SYSTEMD_STATUS_UP     = "Up (systemd)"
+	SYSTEMD_STATUS_EXITED = "Exited (systemd: %s)"
+
+	SYSTEMD_FLAGS_PREFIX = "FLAGS_"
+)
+
+// the component binary which runs the role on bare-metal
+var SYSTEMD_COMPONENTS = map[string]string{
+	topology.ROLE_FS_MDS:     component.DINGO_MDS,
+	topology.ROLE_FS_MDS_CLI: component.DINGO_MDS_CLIENT,
+	topology.ROLE_FS_CACHE:   component.DINGO_DACHE,
+}
+
+// the environments consumed by the entrypoint of image, which are translated
+// into gflags of service on bare-metal, e.g. COORDINATOR_ADDR
+var SYSTEMD_ENTRYPOINT_FLAGS = map[string]string{
+	ENV_DINGO_SERVER_LISTEN_HOST:     "--mds_server_listen_host=%s",
+	ENV_DINGO_SERVER_HOST:            "--mds_server_host=%s",
+	ENV_DINGO_SERVER_START_PORT:      "--mds_server_port=%s",
+	ENV_DINGOFS_V2_INSTANCE_START_ID: "--mds_server_id=%s",
+	ENV_DINGOSTORE_COORDINATOR_ADDR:  "--storage_url=list://%s",
+	ENV_DINGOFS_V2_CLUSTER_ID:        "--cluster_id=%s",
+}
+
+type (
+	step2GetSystemdStatus struct {
+		unit        string
+		status      *string
+		execOptions module.ExecOptions
+	}
+
+	step2GetSystemdListenPorts struct {
+		unit        string
+		status      *string
+		ports       *string
+		execOptions module.ExecOptions
+	}
+
+	step2GetSystemdLeader struct {
+		dc          *topology.DeployConfig
+		status      *string
+		isLeader    *bool
+		execOptions module.ExecOptions
+	}
+)
+
+func isSystemd(dc *topology.DeployConfig) bool {
+	return dc.GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD
+}
+
+func systemdBinaryPath(dc *topology.DeployConfig) string {
+	return path.Join(SYSTEMD_BINARY_DIR, SYSTEMD_COMPONENTS[dc.GetRole()])
+}
+
+func systemdConfDir(serviceId string) string {
+	return path.Join(SYSTEMD_CONF_DIR, serviceId)
+}
+
+func systemdUnitName(serviceId string) string {
+	return fmt.Sprintf("%s-%s.service", SYSTEMD_UNIT_PREFIX, serviceId)
+}
+
+func systemdUnitPath(serviceId string) string {
+	return path.Join(SYSTEMD_UNIT_DIR, systemdUnitName(serviceId))
+}
+
+func getSystemdRestartPolicy(dc *topology.DeployConfig) string {
+	policy := getRestartPolicy(dc)
+	switch {
+	case policy == POLICY_NEVER_RESTART:
+		return "no"
+	case strings.HasPrefix(policy, "on-failure"):
+		return "on-failure"
+	}
+	return POLICY_ALWAYS_RESTART // always, unless-stopped
+}
+
+// getSystemdCMD returns the command line of service, the gflags passed by
+// environment in container and the environments consumed by the entrypoint
+// of image are passed by command line options instead
+func getSystemdCMD(dc *topology.DeployConfig, serviceId string) string {
+	args := []string{systemdBinaryPath(dc)}
+	for _, conf := range dc.GetProjectLayout().ServiceConfFiles {
+		args = append(args, fmt.Sprintf("--flagfile=%s", path.Join(systemdConfDir(serviceId), conf.Name)))
+	}
+	for _, env := range GetEnvironments(dc) {
+		kv := strings.SplitN(env, "=", 2)
+		if len(kv) != 2 {
+			continue
+		} else if format, ok := SYSTEMD_ENTRYPOINT_FLAGS[kv[0]]; ok {
+			args = append(args, fmt.Sprintf(format, kv[1]))
+			continue
+		} else if !strings.HasPrefix(kv[0], SYSTEMD_FLAGS_PREFIX) {
+			continue
+		} else if kv[0] == ENV_DINGOFS_CACHE_FLAGS_CACHE_DIR {
+			kv[1] = dc.GetCacheDir() // no volume mapping on bare-metal
+		}
+		args = append(args, fmt.Sprintf("--%s=%s", strings.TrimPrefix(kv[0], SYSTEMD_FLAGS_PREFIX), kv[1]))
+	}
+	if logDir := dc.GetLogDir(); len(logDir) > 0 {
+		args = append(args, fmt.Sprintf("--log_dir=%s", logDir))
+	}
+	return strings.Join(args, " ")
+}
+
+// the environments which are not passed by command line options,
+// e.g. the env of service in topology
+func getSystemdEnvironments(dc *topology.DeployConfig) string {
+	envs := []string{}
+	for _, env := range GetEnvironments(dc) {
+		key := strings.SplitN(env, "=", 2)[0]
+		if _, ok := SYSTEMD_ENTRYPOINT_FLAGS[key]; ok {
+			continue
+		} else if !strings.HasPrefix(key, SYSTEMD_FLAGS_PREFIX) {
+			envs = append(envs, env)
+		}
+	}
+	return strings.Join(envs, "\n") + "\n"
+}
+
+func newSystemdUnit(dc *topology.DeployConfig, serviceId string) string {
+	lines := []string{
+		"[Unit]",
+		fmt.Sprintf("Description=DingoFS %s service (%s)", dc.GetRole(), dc.GetId()),
+		"After=network-online.target",
+		"Wants=network-online.target",
+		"",
+		"[Service]",
+		"Type=simple",
+		fmt.Sprintf("EnvironmentFile=-%s", path.Join(systemdConfDir(serviceId), SYSTEMD_ENV_FILE)),
+		fmt.Sprintf("ExecStart=%s", getSystemdCMD(dc, serviceId)),
+		fmt.Sprintf("Restart=%s", getSystemdRestartPolicy(dc)),
+		"RestartSec=3",
+		"LimitNOFILE=1048576",
+		"LimitCORE=infinity",
+		"",
+		"[Install]",
+		"WantedBy=multi-user.target",
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func findSystemdComponent(dc *topology.DeployConfig) (*component.Component, error) {
+	name := SYSTEMD_COMPONENTS[dc.GetRole()]
+	cm, err := component.NewLocalComponentManager()
+	if err != nil {
+		return nil, errno.ERR_COMPONENT_BINARY_NOT_INSTALLED.E(err)
+	}
+	comp, err := cm.FindPushComponent(name, "")
+	if err != nil {
+		return nil, errno.ERR_COMPONENT_BINARY_NOT_INSTALLED.
+			F("component=%s, please install it by 'dingo component install %s'", name, name)
+	}
+	return comp, nil
+}
+
+// the config template is installed with component binary (<build>/conf/<name>)
+// from the confs of build in repository
+func readSystemdConfTemplate(comp *component.Component, name string, content *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		filename := comp.ConfPath(name)
+		if !utils.PathExist(filename) {
+			return errno.ERR_COMPONENT_BINARY_NOT_INSTALLED.
+				F("component %s:%s ships no config template %s, please install a build which ships it, "+
+					"e.g. 'dingo component update %s'", comp.Name, comp.Version, name, comp.Name)
+		}
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return errno.ERR_READ_FILE_FAILED.E(err)
+		}
+		*content = string(data)
+		return nil
+	}
+}
+
+func checkSystemdActive(host, role, unit string, state *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if *state != SYSTEMD_STATE_ACTIVE {
+			return errno.ERR_SYSTEMD_SERVICE_NOT_ACTIVE.
+				F("host=%s role=%s unit=%s state=%s", host, role, unit, *state)
+		}
+		return nil
+	}
+}
+
+func setSystemdServiceCleaned(dingocli *cli.DingoCli, serviceId string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		return dingocli.Storage().SetContainId(serviceId, comm.CLEANED_CONTAINER_ID)
+	}
+}
+
+func (s *step2GetSystemdStatus) Execute(ctx *context.Context) error {
+	var state string
+	var success bool
+	step := &step.Systemctl{
+		Action:      "is-active",
+		Units:       []string{s.unit},
+		Success:     &success,
+		Out:         &state,
+		ExecOptions: s.execOptions,
+	}
+	if err := step.Execute(ctx); err != nil {
+		return err
+	}
+
+	if state == SYSTEMD_STATE_ACTIVE {
+		*s.status = SYSTEMD_STATUS_UP
+	} else if len(state) > 0 {
+		*s.status = fmt.Sprintf(SYSTEMD_STATUS_EXITED, state)
+	}
+	return nil
+}
+
+// execute the "ss" command on host and pick the sockets of service main process
+func (s *step2GetSystemdListenPorts) Execute(ctx *context.Context) error {
+	if !strings.HasPrefix(*s.status, "Up") {
+		return nil
+	}
+
+	cmd := ctx.Module().Shell().Systemctl("show", s.unit)
+	cmd.AddOption("--property=MainPID")
+	cmd.AddOption("--value")
+	pid, err := cmd.Execute(s.execOptions)
+	pid = strings.TrimSpace(pid)
+	if err != nil || len(pid) == 0 || pid == "0" {
+		return nil
+	}
+
+	cmd = ctx.Module().Shell().SocketStatistics("")
+	cmd.AddOption("-n")
+	cmd.AddOption("--no-header")
+	cmd.AddOption("--processes")
+	cmd.AddOption("--listening")
+	out, err := cmd.Execute(s.execOptions)
+	if err != nil {
+		return nil
+	}
+
+	ports := []string{}
+	getter := &Step2GetListenPorts{}
+	for _, line := range strings.Split(out, "\n") {
+		if !strings.Contains(line, fmt.Sprintf("pid=%s,", pid)) {
+			continue
+		}
+		port := getter.extractPort(line, "ss")
+		if len(port) > 0 && !utils.Contains(ports, port) {
+			ports = append(ports, port)
+		}
+	}
+	*s.ports = strings.Join(ports, ",")
+	return nil
+}
+
+func (s *step2GetSystemdLeader) Execute(ctx *context.Context) error {
+	dc := s.dc
+	if !strings.HasPrefix(*s.status, "Up") {
+		return nil
+	} else if dc.GetRole() != topology.ROLE_FS_MDS {
+		return nil
+	}
+
+	url := fmt.Sprintf(URL_DINGOFS_METRIC_LEADER, dc.GetListenIp(), dc.GetListenDummyPort())
+	cmd := ctx.Module().Shell().Command(fmt.Sprintf(COMMAND_CURL_MDS, url))
+	out, _ := cmd.Execute(s.execOptions)
+	*s.isLeader = strings.Contains(out, SIGNATURE_LEADER)
+	return nil
+}
+
+// NewInstallBinaryTask installs the component binary of service into host
+func NewInstallBinaryTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	comp, err := findSystemdComponent(dc)
+	if err != nil {
+		return nil, err
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s component=%s:%s", dc.GetHost(), comp.Name, comp.Version)
+	t := task.NewTask("Install Binary", subname, hc.GetSSHConfig())
+
+	// add step to task
+	t.AddStep(&step.CreateDirectory{
+		Paths:       []string{SYSTEMD_BINARY_DIR},
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.UploadFile{
+		LocalPath:   filepath.Join(comp.Path, comp.Name),
+		RemotePath:  systemdBinaryPath(dc),
+		Mode:        "0755",
+		ExecOptions: dingocli.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+// NewCreateSystemdServiceTask creates the directories of service, the unit
+// is installed by sync config, the service id is recorded as its container id
+func NewCreateSystemdServiceTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s", dc.GetHost(), dc.GetRole())
+	t := task.NewTask("Create Systemd Service", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var oldContainerId string
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId := serviceId
+	t.AddStep(&Step2GetService{ // if service exist, break task
+		ServiceId:   serviceId,
+		ContainerId: &oldContainerId,
+		Storage:     dingocli.Storage(),
+	})
+
+	createDir := []string{systemdConfDir(serviceId)}
+	if dc.GetRole() != topology.ROLE_FS_MDS_CLI {
+		createDir = append(createDir, dc.GetLogDir(), dc.GetDataDir())
+	}
+	if dc.GetRole() == topology.ROLE_FS_CACHE {
+		createDir = append(createDir, dc.GetCacheDir())
+	}
+	t.AddStep(&step.CreateDirectory{
+		Paths:       createDir,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&Step2InsertService{
+		ClusterId:      dingocli.ClusterId(),
+		ServiceId:      serviceId,
+		ContainerId:    &containerId,
+		OldContainerId: &oldContainerId,
+		Storage:        dingocli.Storage(),
+	})
+
+	return t, nil
+}
+
+// NewSyncSystemdConfigTask renders the service config into /etc/dingofs/<serviceId>
+// and installs the systemd unit of service
+func NewSyncSystemdConfigTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if err != nil {
+		return nil, err
+	} else if containerId == comm.CLEANED_CONTAINER_ID {
+		return nil, nil
+	}
+	comp, err := findSystemdComponent(dc)
+	if err != nil {
+		return nil, err
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s serviceId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(serviceId))
+	t := task.NewTask("Sync Config", subname, hc.GetSSHConfig())
+
+	// add step to task
+	confDir := systemdConfDir(serviceId)
+	for _, conf := range dc.GetProjectLayout().ServiceConfFiles {
+		var input, output string
+		t.AddStep(&step.Lambda{
+			Lambda: readSystemdConfTemplate(comp, conf.Name, &input),
+		})
+		t.AddStep(&step.Filter{ // render service config, e.g. mds.template.conf
+			KVFieldSplit:  CONFIG_DELIMITER_ASSIGN,
+			Mutate:        NewMutate(dc, CONFIG_DELIMITER_ASSIGN, false),
+			SerivceConfig: dc.GetServiceConfig(),
+			Input:         &input,
+			Output:        &output,
+		})
+		t.AddStep(&step.InstallFile{
+			HostDestPath: path.Join(confDir, conf.Name),
+			Content:      &output,
+			ExecOptions:  dingocli.ExecOptions(),
+		})
+	}
+
+	if dc.GetRole() == topology.ROLE_FS_MDS_CLI {
+		// mds client runs once to create meta tables, no service
+		createTablesScript := scripts.CREATE_MDS_TABLES
+		t.AddStep(&step.InstallFile{
+			HostDestPath: path.Join(confDir, topology.SCRIPT_CREATE_MDSV2_TABLES),
+			Content:      &createTablesScript,
+			ExecOptions:  dingocli.ExecOptions(),
+		})
+		return t, nil
+	}
+
+	envs := getSystemdEnvironments(dc)
+	unit := newSystemdUnit(dc, serviceId)
+	t.AddStep(&step.InstallFile{
+		HostDestPath: path.Join(confDir, SYSTEMD_ENV_FILE),
+		Content:      &envs,
+		ExecOptions:  dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.InstallFile{
+		HostDestPath: systemdUnitPath(serviceId),
+		Content:      &unit,
+		ExecOptions:  dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Systemctl{
+		Action:      "daemon-reload",
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Systemctl{
+		Action:      "enable",
+		Units:       []string{systemdUnitName(serviceId)},
+		ExecOptions: dingocli.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+// newSystemdServiceTask maps start/stop/restart of service onto systemctl
+func newSystemdServiceTask(dingocli *cli.DingoCli, dc *topology.DeployConfig, name, action string) (*task.Task, error) {
+	if dc.GetRole() == topology.ROLE_FS_MDS_CLI {
+		return nil, nil
+	}
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if err != nil {
+		return nil, err
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s serviceId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(serviceId))
+	t := task.NewTask(name, subname, hc.GetSSHConfig())
+
+	// add step to task
+	var state string
+	var success bool
+	unit := systemdUnitName(serviceId)
+	t.AddStep(&step.Lambda{
+		Lambda: checkContainerId(containerId),
+	})
+	t.AddStep(&step.Systemctl{
+		Action:      action,
+		Units:       []string{unit},
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	if action == "stop" {
+		return t, nil
+	}
+
+	t.AddStep(&step.Lambda{
+		Lambda: WaitContainerStart(3),
+	})
+	t.AddStep(&step.Systemctl{
+		Action:      "is-active",
+		Units:       []string{unit},
+		Success:     &success,
+		Out:         &state,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: checkSystemdActive(dc.GetHost(), dc.GetRole(), unit, &state),
+	})
+
+	return t, nil
+}
+
+func NewGetSystemdServiceStatusTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if err != nil {
+		return nil, err
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s serviceId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(serviceId))
+	t := task.NewTask("Get Service Status", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var status string
+	var ports string
+	var isLeader bool
+	unit := systemdUnitName(serviceId)
+	if containerId != comm.CLEANED_CONTAINER_ID {
+		t.AddStep(&step2GetSystemdStatus{
+			unit:        unit,
+			status:      &status,
+			execOptions: dingocli.ExecOptions(),
+		})
+	}
+	t.AddStep(&step2GetSystemdListenPorts{
+		unit:        unit,
+		status:      &status,
+		ports:       &ports,
+		execOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step2GetSystemdLeader{
+		dc:          dc,
+		status:      &status,
+		isLeader:    &isLeader,
+		execOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step2FormatServiceStatus{
+		dc:          dc,
+		serviceId:   serviceId,
+		containerId: containerId,
+		isLeader:    &isLeader,
+		ports:       &ports,
+		status:      &status,
+		memStorage:  dingocli.MemStorage(),
+	})
+
+	return t, nil
+}
+
+func NewCleanSystemdServiceTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	only := dingocli.MemStorage().Get(comm.KEY_CLEAN_ITEMS).([]string)
+	subname := fmt.Sprintf("host=%s role=%s serviceId=%s clean=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(serviceId), strings.Join(only, ","))
+	t := task.NewTask("Clean Service", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var success bool
+	clean := utils.Slice2Map(only)
+	files := getCleanFiles(clean, dc) // directorys which need cleaned
+	if clean[comm.CLEAN_ITEM_DATA] && dc.GetRole() == topology.ROLE_FS_CACHE {
+		files = append(files, dc.GetCacheDir())
+	}
+	t.AddStep(&step.RemoveFile{
+		Files:       files,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	if !clean[comm.CLEAN_ITEM_CONTAINER] {
+		return t, nil
+	}
+
+	unit := systemdUnitName(serviceId)
+	t.AddStep(&step.Systemctl{ // unit not exist for mds client
+		Action:      "disable",
+		Units:       []string{unit},
+		Now:         true,
+		Success:     &success,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.RemoveFile{
+		Files:       []string{systemdUnitPath(serviceId), systemdConfDir(serviceId)},
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Systemctl{
+		Action:      "daemon-reload",
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: setSystemdServiceCleaned(dingocli, serviceId),
+	})
+
+	return t, nil
+}
+
+// NewCreateSystemdMetaTablesTask runs create_mds_tables.sh on host with the mds client binary
+func NewCreateSystemdMetaTablesTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	t := task.NewTask("Create Meta Tables", "Create Meta Tables", hc.GetSSHConfig())
+
+	// add step to task
+	var success bool
+	var out string
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	envs := []string{}
+	for _, env := range GetEnvironments(dc) {
+		if strings.HasPrefix(env, ENV_DINGOSTORE_COORDINATOR_ADDR+"=") {
+			envs = append(envs, env)
+		}
+	}
+	t.AddStep(&step.Command{
+		Command: fmt.Sprintf("env %s bash %s %s %d", strings.Join(envs, " "),
+			path.Join(systemdConfDir(serviceId), topology.SCRIPT_CREATE_MDSV2_TABLES),
+			systemdBinaryPath(dc), dc.GetDingoClusterId()),
+		Success:     &success,
+		Out:         &out,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: checkCreateTableSuccess(&success, &out),
+	})
+
+	return t, nil
+}
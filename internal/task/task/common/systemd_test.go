@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const SYSTEMD_TOPOLOGY = `
+kind: dingofs
+global:
+  deploy_mode: systemd
+  log_dir: /var/log/dingofs/${service_role}
+  coordinator_addr: 10.0.0.1:22001,10.0.0.2:22001
+  cluster_id: 1
+  env: MALLOC_CONF=background_thread:true
+
+mds_services:
+  config:
+    server.port: 7400
+    log_level: WARNING
+  deploy:
+    - host: 10.0.0.1
+
+cache_services:
+  config:
+    listen.port: 10000
+    cache.dir: /data/cache
+  deploy:
+    - host: 10.0.0.1
+`
+
+func parseSystemdTopology(t *testing.T, role string) *topology.DeployConfig {
+	ctx := topology.NewContext()
+	ctx.Add("10.0.0.1", "10.0.0.1")
+	dcs, err := topology.ParseTopology(SYSTEMD_TOPOLOGY, ctx)
+	require.NoError(t, err)
+	for _, dc := range dcs {
+		if dc.GetRole() == role {
+			return dc
+		}
+	}
+	t.Fatalf("role %s not found in topology", role)
+	return nil
+}
+
+func TestSystemdCMD(t *testing.T) {
+	assert := assert.New(t)
+
+	dc := parseSystemdTopology(t, topology.ROLE_FS_MDS)
+	cmd := getSystemdCMD(dc, "abc")
+	args := strings.Split(cmd, " ")
+	assert.Equal("/usr/local/dingofs/bin/dingo-mds", args[0])
+	for _, conf := range dc.GetProjectLayout().ServiceConfFiles {
+		assert.Contains(args, fmt.Sprintf("--flagfile=/etc/dingofs/abc/%s", conf.Name))
+	}
+	assert.Contains(args, "--role=mds")
+	assert.Contains(args, "--mds_server_listen_host=0.0.0.0")
+	assert.Contains(args, "--mds_server_port=7400")
+	assert.Contains(args, "--mds_server_host=10.0.0.1")
+	assert.Contains(args, "--storage_url=list://10.0.0.1:22001,10.0.0.2:22001")
+	assert.Contains(args, "--mds_server_id=1001")
+	assert.Contains(args, "--cluster_id=1")
+	assert.Contains(args, "--log_dir=/var/log/dingofs/mds")
+	assert.NotContains(cmd, "COORDINATOR_ADDR")
+	assert.NotContains(cmd, "MALLOC_CONF")
+
+	// the entrypoint environments are not written into env file
+	envs := getSystemdEnvironments(dc)
+	assert.Equal("MALLOC_CONF=background_thread:true\n", envs)
+}
+
+func TestSystemdCMDCacheDir(t *testing.T) {
+	assert := assert.New(t)
+
+	dc := parseSystemdTopology(t, topology.ROLE_FS_CACHE)
+	args := strings.Split(getSystemdCMD(dc, "abc"), " ")
+	assert.Equal("/usr/local/dingofs/bin/dingo-cache", args[0])
+	assert.Contains(args, "--cache_dir=/data/cache")
+	assert.Contains(args, "--role=cache")
+}
+
+func TestNewSystemdUnit(t *testing.T) {
+	assert := assert.New(t)
+
+	dc := parseSystemdTopology(t, topology.ROLE_FS_MDS)
+	unit := newSystemdUnit(dc, "abc")
+	lines := strings.Split(unit, "\n")
+	assert.Equal("[Unit]", lines[0])
+	assert.Contains(lines, "[Service]")
+	assert.Contains(lines, "[Install]")
+	assert.Contains(lines, "EnvironmentFile=-/etc/dingofs/abc/env")
+	assert.Contains(lines, fmt.Sprintf("ExecStart=%s", getSystemdCMD(dc, "abc")))
+	assert.Contains(lines, "Restart=always")
+	assert.Contains(lines, "WantedBy=multi-user.target")
+	assert.True(strings.HasSuffix(unit, "\n"))
+}
+
+// create a mirror which ships dingo-mds v1.0.0 with its config template
+func newSystemdMirror(t *testing.T, template string) string {
+	mirror := t.TempDir()
+	files := map[string]string{
+		"/v1.0.0/dingo-mds":              "dingo-mds",
+		"/v1.0.0/conf/mds.template.conf": template,
+	}
+	for path, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(mirror, path)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(mirror, path), []byte(content), 0755))
+	}
+
+	for _, name := range component.ALL_COMPONENTS {
+		repodata := &component.BinaryRepoData{Binary: name, Tags: map[string]component.BinaryDetail{}}
+		if name == component.DINGO_MDS {
+			repodata.Tags["v1.0.0"] = component.BinaryDetail{
+				Path:      "/v1.0.0/dingo-mds",
+				BuildTime: "2026-01-01",
+				Confs: map[string]component.ConfDetail{
+					"mds.template.conf": {Path: "/v1.0.0/conf/mds.template.conf"},
+				},
+			}
+		}
+		data, err := json.Marshal(repodata)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(mirror, name+".version"), data, 0644))
+	}
+	return mirror
+}
+
+// install the component from mirror, then render its template as sync config does
+func TestSyncSystemdConfigFromInstalledComponent(t *testing.T) {
+	assert := assert.New(t)
+
+	template := "--mds_server_port=6900\n--log_level=INFO\n"
+	t.Setenv("DINGOFS_MIRROR", "file://"+newSystemdMirror(t, template))
+	t.Setenv("DINGOFS_MIRROR_PUBLIC_KEY", "")
+	os.Unsetenv("DINGOFS_MIRROR_PUBLIC_KEY")
+	repostoryDir := component.RepostoryDir
+	component.RepostoryDir = t.TempDir()
+	defer func() { component.RepostoryDir = repostoryDir }()
+
+	cm, err := component.NewComponentManager()
+	require.NoError(t, err)
+	_, err = cm.InstallComponent(component.DINGO_MDS, "v1.0.0")
+	require.NoError(t, err)
+
+	dc := parseSystemdTopology(t, topology.ROLE_FS_MDS)
+	comp, err := findSystemdComponent(dc)
+	require.NoError(t, err)
+	for _, conf := range dc.GetProjectLayout().ServiceConfFiles {
+		var input, output string
+		require.NoError(t, readSystemdConfTemplate(comp, conf.Name, &input)(nil))
+		assert.Equal(template, input)
+
+		filter := &step.Filter{
+			KVFieldSplit:  CONFIG_DELIMITER_ASSIGN,
+			Mutate:        NewMutate(dc, CONFIG_DELIMITER_ASSIGN, false),
+			SerivceConfig: dc.GetServiceConfig(),
+			Input:         &input,
+			Output:        &output,
+		}
+		require.NoError(t, filter.Execute(nil))
+		assert.Contains(output, "--mds_server_port=6900")
+		assert.Contains(output, "--log_level=WARNING")
+	}
+
+	// the build ships no such template
+	var input string
+	assert.Error(readSystemdConfTemplate(comp, "unknown.conf", &input)(nil))
+}
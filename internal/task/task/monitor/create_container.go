@@ -27,11 +27,6 @@ import (
 	"github.com/dingodb/dingocli/internal/task/task/common"
 )
 
-const (
-	ENGINE_DOCKER = "docker"
-	ENGINE_PODMAN = "podman"
-)
-
 func getEntrypoint(cfg *configure.MonitorConfig) string {
 	role := cfg.GetRole()
 	if role == ROLE_MONITOR_SYNC {
@@ -180,7 +175,7 @@ func NewCreateContainerTask(dingocli *cli.DingoCli, cfg *configure.MonitorConfig
 		AddHost:     []string{fmt.Sprintf("%s:127.0.0.1", hostname)},
 		Envs:        getEnvironments(cfg),
 		Hostname:    hostname,
-		Init:        dingocli.Engine() == ENGINE_DOCKER,
+		Init:        true, // ignored if the engine doesn't support it
 		Name:        hostname,
 		Privileged:  true,
 		User:        "0:0",
@@ -18,6 +18,7 @@ package task
 
 import (
 	"errors"
+	"time"
 
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/task/context"
@@ -44,6 +45,12 @@ type (
 		postSteps []Step
 		sshConfig *module.SSHConfig
 		context   context.Context
+		recorder  *module.Recorder
+		serviceId string
+		role      string
+		output    *module.Output
+		startTime time.Time
+		endTime   time.Time
 	}
 )
 
@@ -55,6 +62,7 @@ func NewTask(name, subname string, sshConfig *module.SSHConfig) *Task {
 		name:      name,
 		subname:   subname,
 		sshConfig: sshConfig,
+		output:    module.NewOutput(),
 	}
 }
 
@@ -74,6 +82,34 @@ func (t *Task) Subname() string {
 	return t.subname
 }
 
+func (t *Task) Host() string {
+	if t.sshConfig == nil {
+		return module.LOCAL_HOST
+	}
+	return t.sshConfig.Host
+}
+
+func (t *Task) ServiceId() string {
+	return t.serviceId
+}
+
+func (t *Task) Role() string {
+	return t.role
+}
+
+// Output returns the captured stdout and stderr of commands executed by the task
+func (t *Task) Output() *module.Output {
+	return t.output
+}
+
+func (t *Task) StartTime() time.Time {
+	return t.startTime
+}
+
+func (t *Task) EndTime() time.Time {
+	return t.endTime
+}
+
 func (t *Task) SetTid(tid string) {
 	t.tid = tid
 }
@@ -86,6 +122,19 @@ func (t *Task) SetSubname(name string) {
 	t.subname = name
 }
 
+func (t *Task) SetServiceId(id string) {
+	t.serviceId = id
+}
+
+func (t *Task) SetRole(role string) {
+	t.role = role
+}
+
+// SetRecorder makes the task record commands instead of executing them (dry-run)
+func (t *Task) SetRecorder(recorder *module.Recorder) {
+	t.recorder = recorder
+}
+
 func (t *Task) AddStep(step Step) {
 	t.steps = append(t.steps, step)
 }
@@ -103,7 +152,39 @@ func (t *Task) executePost(ctx *context.Context) {
 	}
 }
 
+func (t *Task) executeDryRun() error {
+	var sshClient *module.SSHClient
+	host := module.LOCAL_HOST
+	if t.sshConfig != nil {
+		sshClient = module.NewOfflineSSHClient(*t.sshConfig)
+		host = t.sshConfig.Host
+	}
+
+	recorder := t.recorder.ForTask(t.name, t.subname, host)
+	ctx := context.NewRecordContext(sshClient, recorder)
+	defer t.executePost(ctx)
+
+	for _, step := range t.steps {
+		err := step.Execute(ctx)
+		if err == ERR_TASK_DONE || err == ERR_SKIP_TASK {
+			break
+		} else if err != nil {
+			// commands are not really executed, the rest steps can't be planned
+			recorder.Abort(err)
+			break
+		}
+	}
+	return nil
+}
+
 func (t *Task) Execute() error {
+	if t.recorder != nil {
+		return t.executeDryRun()
+	}
+
+	t.startTime = time.Now()
+	defer func() { t.endTime = time.Now() }()
+
 	var sshClient *module.SSHClient
 	if t.sshConfig != nil {
 		client, err := module.NewSSHClient(*t.sshConfig)
@@ -113,7 +194,7 @@ func (t *Task) Execute() error {
 		sshClient = client
 	}
 
-	ctx, err := context.NewContext(sshClient)
+	ctx, err := context.NewContext(sshClient, t.output)
 	if err != nil {
 		return err
 	}
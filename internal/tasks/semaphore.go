@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package tasks
+
+import (
+	"sync"
+)
+
+// semaphore limits the tasks executed in parallel which have the same key, e.g. host or role
+type semaphore struct {
+	limit func(key string) uint
+	chans map[string]chan struct{}
+	mutex sync.Mutex
+}
+
+func newSemaphore(limit func(key string) uint) *semaphore {
+	return &semaphore{
+		limit: limit,
+		chans: map[string]chan struct{}{},
+	}
+}
+
+func (s *semaphore) get(key string) chan struct{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if ch, ok := s.chans[key]; ok {
+		return ch
+	}
+
+	var ch chan struct{}
+	if n := s.limit(key); n > 0 && len(key) > 0 {
+		ch = make(chan struct{}, n)
+	}
+	s.chans[key] = ch
+	return ch
+}
+
+// acquire blocks until the key is available and returns the release function,
+// the key without limit is always available
+func (s *semaphore) acquire(key string) func() {
+	ch := s.get(key)
+	if ch == nil {
+		return func() {}
+	}
+
+	ch <- struct{}{}
+	return func() { <-ch }
+}
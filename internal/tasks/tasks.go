@@ -30,10 +30,12 @@ import (
 
 type (
 	ExecOptions struct {
-		Concurrency   uint
-		SilentMainBar bool
-		SilentSubBar  bool
-		SkipError     bool
+		Concurrency     uint
+		HostConcurrency uint            // tasks executed in parallel on one host, 0 means no limit
+		RoleConcurrency map[string]uint // tasks executed in parallel for one service role
+		SilentMainBar   bool
+		SilentSubBar    bool
+		SkipError       bool
 	}
 
 	Tasks struct {
@@ -43,6 +45,7 @@ type (
 		progress *mpb.Progress
 		mainBar  *mpb.Bar
 		subBar   map[string]*mpb.Bar
+		onDone   func(t *task.Task, err error)
 		sync.Mutex
 	}
 )
@@ -63,6 +66,33 @@ func (ts *Tasks) AddTask(t ...*task.Task) {
 	ts.tasks = append(ts.tasks, t...)
 }
 
+// Name returns the name of tasks, e.g. Pull Image
+func (ts *Tasks) Name() string {
+	if len(ts.tasks) == 0 {
+		return ""
+	}
+	return ts.tasks[0].Name()
+}
+
+// Skip removes the tasks which needn't execute, e.g. succeeded in the resumed run
+func (ts *Tasks) Skip(skip func(t *task.Task) bool) int {
+	tasks := []*task.Task{}
+	for _, t := range ts.tasks {
+		if !skip(t) {
+			tasks = append(tasks, t)
+		}
+	}
+	n := len(ts.tasks) - len(tasks)
+	ts.tasks = tasks
+	return n
+}
+
+// OnDone sets the callback which is invoked after each task executed,
+// it may be invoked concurrently
+func (ts *Tasks) OnDone(fn func(t *task.Task, err error)) {
+	ts.onDone = fn
+}
+
 func (ts *Tasks) CountPtid(ptid string) int64 {
 	var sum int64 = 0
 	for _, t := range ts.tasks {
@@ -204,9 +234,17 @@ func (ts *Tasks) Execute(options ExecOptions) error {
 	ts.prettySubname()
 	options = ts.initOptions(options)
 	workers := make(chan struct{}, options.Concurrency)
+	hosts := newSemaphore(func(string) uint { return options.HostConcurrency })
+	roles := newSemaphore(func(role string) uint { return options.RoleConcurrency[role] })
 	if !options.SilentMainBar {
 		ts.addMainBar()
 	}
+	if !options.SilentSubBar {
+		// added before workers started, so the bars are in order of tasks
+		for _, t := range ts.tasks {
+			ts.addSubBar(t)
+		}
+	}
 
 	// execute task by concurrency
 	for _, t := range ts.tasks {
@@ -216,19 +254,23 @@ func (ts *Tasks) Execute(options ExecOptions) error {
 		// 	break
 		// }
 		ts.wg.Add(1)
-		workers <- struct{}{}
-		if !options.SilentSubBar {
-			ts.addSubBar(t)
-		}
 
 		// worker
 		go func(t *task.Task) {
+			// always acquire in order of host, role and worker to avoid dead lock,
+			// the task waiting for its host or role doesn't occupy worker
+			releaseHost := hosts.acquire(t.Host())
+			releaseRole := roles.acquire(t.Role())
+			workers <- struct{}{}
+
 			bar := ts.getSubBar(t)
 			defer func() {
 				if bar != nil {
 					bar.IncrBy(1)
 				}
 				<-workers
+				releaseRole()
+				releaseHost()
 				ts.wg.Done()
 			}()
 
@@ -239,6 +281,9 @@ func (ts *Tasks) Execute(options ExecOptions) error {
 			}
 			err := t.Execute()
 			ts.monitor.set(id, err)
+			if ts.onDone != nil {
+				ts.onDone(t, err)
+			}
 		}(t)
 	}
 
@@ -26,17 +26,36 @@ import (
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
 )
 
 const (
 	TEMPLATE_SCP                             = `scp -P {{.port}} {{or .options ""}} {{.source}} {{.user}}@{{.host}}:{{.target}}`
 	TEMPLATE_SSH_COMMAND                     = `ssh {{.user}}@{{.host}} -p {{.port}} {{or .options ""}} {{or .become ""}} {{.command}}`
 	TEMPLATE_SSH_ATTACH                      = `ssh -tt {{.user}}@{{.host}} -p {{.port}} {{or .options ""}} {{or .become ""}} {{.command}}`
-	TEMPLATE_COMMAND_EXEC_CONTAINER          = `{{.sudo}} {{.engine}} exec -it {{.container_id}} /bin/bash -c "cd {{.home_dir}}; /bin/bash"`
-	TEMPLATE_LOCAL_EXEC_CONTAINER            = `{{.engine}} exec -it {{.container_id}} /bin/bash` // FIXME: merge it
-	TEMPLATE_COMMAND_EXEC_CONTAINER_NOATTACH = `{{.sudo}} {{.engine}} exec -t {{.container_id}} /bin/bash -c "{{.command}}"`
+	TEMPLATE_COMMAND_EXEC_CONTAINER          = `{{.sudo}} {{.engine}} exec {{.flags}} {{.container_id}} /bin/bash -c "cd {{.home_dir}}; /bin/bash"`
+	TEMPLATE_LOCAL_EXEC_CONTAINER            = `{{.engine}} exec {{.flags}} {{.container_id}} /bin/bash` // FIXME: merge it
+	TEMPLATE_COMMAND_EXEC_CONTAINER_NOATTACH = `{{.sudo}} {{.engine}} exec {{.flags}} {{.container_id}} /bin/bash -c "{{.command}}"`
 )
 
+func engineBinary(dingocli *cli.DingoCli) string {
+	return module.GetEngine(dingocli.Config().GetEngine()).Binary
+}
+
+// execFlags returns the `exec` options of engine, e.g. nerdctl allocates tty only with stdin
+func execFlags(dingocli *cli.DingoCli, interactive bool) string {
+	engine := module.GetEngine(dingocli.Config().GetEngine())
+	return strings.Join(engine.ExecFlags(interactive, true, ""), " ")
+}
+
+// engineSudo returns the sudo prefix of engine command, rootless engine runs as the ssh user
+func engineSudo(dingocli *cli.DingoCli) string {
+	if module.GetEngine(dingocli.Config().GetEngine()).Rootless {
+		return ""
+	}
+	return dingocli.Config().GetSudoAlias()
+}
+
 func prepareOptions(dingocli *cli.DingoCli, host string, become bool, extra map[string]interface{}) (map[string]interface{}, error) {
 	options := map[string]interface{}{}
 	hc, err := dingocli.GetHost(host)
@@ -129,8 +148,9 @@ func AttachRemoteHost(dingocli *cli.DingoCli, host string, become bool) error {
 
 func AttachRemoteContainer(dingocli *cli.DingoCli, host, containerId, home string) error {
 	data := map[string]interface{}{
-		"sudo":         dingocli.Config().GetSudoAlias(),
-		"engine":       dingocli.Config().GetEngine(),
+		"sudo":         engineSudo(dingocli),
+		"engine":       engineBinary(dingocli),
+		"flags":        execFlags(dingocli, true),
 		"container_id": containerId,
 		"home_dir":     home,
 	}
@@ -152,7 +172,8 @@ func AttachRemoteContainer(dingocli *cli.DingoCli, host, containerId, home strin
 func AttachLocalContainer(dingocli *cli.DingoCli, containerId string) error {
 	data := map[string]interface{}{
 		"container_id": containerId,
-		"engine":       dingocli.Config().GetEngine(),
+		"engine":       engineBinary(dingocli),
+		"flags":        execFlags(dingocli, true),
 	}
 	tmpl := template.Must(template.New("command").Parse(TEMPLATE_LOCAL_EXEC_CONTAINER))
 	buffer := bytes.NewBufferString("")
@@ -165,8 +186,9 @@ func AttachLocalContainer(dingocli *cli.DingoCli, containerId string) error {
 
 func ExecCmdInRemoteContainer(dingocli *cli.DingoCli, host, containerId, cmd string) error {
 	data := map[string]interface{}{
-		"sudo":         dingocli.Config().GetSudoAlias(),
-		"engine":       dingocli.Config().GetEngine(),
+		"sudo":         engineSudo(dingocli),
+		"engine":       engineBinary(dingocli),
+		"flags":        execFlags(dingocli, false),
 		"container_id": containerId,
 		"command":      cmd,
 	}
@@ -185,6 +207,15 @@ func ExecCmdInRemoteContainer(dingocli *cli.DingoCli, host, containerId, cmd str
 	return ssh(dingocli, options)
 }
 
+func ExecCmdInRemoteHost(dingocli *cli.DingoCli, host, cmd string) error {
+	options, err := prepareOptions(dingocli, host, true,
+		map[string]interface{}{"command": cmd})
+	if err != nil {
+		return err
+	}
+	return ssh(dingocli, options)
+}
+
 func Scp(dingocli *cli.DingoCli, host, source, target string) error {
 	options, err := prepareOptions(dingocli, host, false,
 		map[string]interface{}{
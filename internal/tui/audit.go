@@ -18,6 +18,7 @@ package tui
 
 import (
 	"strconv"
+	"strings"
 
 	comm "github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/storage"
@@ -49,9 +50,10 @@ func statusDecorate(message string) string {
 	return message
 }
 
-func FormatAuditLogs(auditLogs []storage.AuditLog, verbose bool) string {
+// runIds is the playbook runs of each audit log, see `dingo history`
+func FormatAuditLogs(auditLogs []storage.AuditLog, runIds map[int][]string, verbose bool) string {
 	lines := [][]interface{}{}
-	title := []string{"Id", "Status", "Execute Time", "Command"}
+	title := []string{"Id", "Status", "Execute Time", "Run Id", "Command"}
 	if verbose {
 		title = append(title, "Work Directory")
 		title = append(title, "Error Code")
@@ -74,6 +76,9 @@ func FormatAuditLogs(auditLogs []storage.AuditLog, verbose bool) string {
 		line = append(line, tuicommon.DecorateMessage{Message: status, Decorate: statusDecorate})
 		// execute time
 		line = append(line, auditLog.ExecuteTime.Format("2006-01-02 15:04:05"))
+		// playbook runs
+		line = append(line, utils.Choose(len(runIds[auditLog.Id]) > 0,
+			strings.Join(runIds[auditLog.Id], ","), "-"))
 		// command
 		line = append(line, auditLog.Command)
 
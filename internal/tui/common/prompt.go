@@ -111,12 +111,25 @@ func PromptRenameCluster(clusterOldName string, clusterNewName string) string {
 	return prompt.Build()
 }
 
+func PromptRestoreCluster(clusterName, backupId string) string {
+	prompt := NewPrompt(color.YellowString(PROMPT_WARNING) + DEFAULT_CONFIRM_PROMPT)
+	prompt.data["warning"] = fmt.Sprintf("WARNING: metadata of cluster '%s' will be overwritten by backup '%s',\n"+
+		"and mds services will be stopped while restoring", clusterName, backupId)
+	return prompt.Build()
+}
+
 func PromptScaleOut() string {
 	prompt := NewPrompt(color.YellowString(PROMPT_TOPOLOGY_CHANGE_NOTICE) + DEFAULT_CONFIRM_PROMPT)
 	prompt.data["operation"] = "scale out cluster"
 	return prompt.Build()
 }
 
+func PromptScaleIn() string {
+	prompt := NewPrompt(color.YellowString(PROMPT_WARNING) + DEFAULT_CONFIRM_PROMPT)
+	prompt.data["warning"] = "WARNING: removed services will be stopped and cleaned up"
+	return prompt.Build()
+}
+
 func PromptMigrate() string {
 	prompt := NewPrompt(color.YellowString(PROMPT_TOPOLOGY_CHANGE_NOTICE) + DEFAULT_CONFIRM_PROMPT)
 	prompt.data["operation"] = "migrate services"
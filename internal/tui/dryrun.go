@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingocli/pkg/module"
+	"github.com/fatih/color"
+)
+
+func indentLines(text, prefix string) string {
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+/*
+ * [Pull Image]
+ *   + host=10.0.0.1  image=dingodatabase/dingofs:latest (10.0.0.1)
+ *     $ docker pull dingodatabase/dingofs:latest
+ * [Sync Config]
+ *   + host=10.0.0.1  role=mds  containerId=1863158e02a6 (10.0.0.1)
+ *     > upload /dingofs/mds/conf/mds.conf
+ *       mds.listen.addr=10.0.0.1:7400
+ */
+func FormatDryRunPlan(records []module.Record) string {
+	steps := []string{}
+	tasks := map[string][]string{}
+	plans := map[string][]module.Record{}
+	for _, record := range records {
+		if _, ok := tasks[record.Step]; !ok {
+			steps = append(steps, record.Step)
+		}
+		key := record.Step + "\x00" + record.Task
+		if _, ok := plans[key]; !ok {
+			tasks[record.Step] = append(tasks[record.Step], record.Task)
+		}
+		plans[key] = append(plans[key], record)
+	}
+
+	lines := []string{}
+	for _, step := range steps {
+		lines = append(lines, color.BlueString("[%s]", step))
+		for _, task := range tasks[step] {
+			plan := plans[step+"\x00"+task]
+			lines = append(lines, fmt.Sprintf("  + %s (%s)", task, plan[0].Host))
+			for _, record := range plan {
+				switch record.Type {
+				case module.RECORD_TYPE_COMMAND:
+					command := "$ " + record.Command
+					if record.Host != plan[0].Host {
+						command = fmt.Sprintf("$ [%s] %s", record.Host, record.Command)
+					}
+					lines = append(lines, "    "+command)
+				case module.RECORD_TYPE_UPLOAD:
+					lines = append(lines, "    > upload "+record.Path)
+					if len(record.Content) > 0 {
+						lines = append(lines, indentLines(record.Content, "      "))
+					} else if len(record.Error) > 0 {
+						lines = append(lines, color.YellowString("      (%s)", record.Error))
+					}
+				case module.RECORD_TYPE_DOWNLOAD:
+					lines = append(lines, "    < download "+record.Path)
+				case module.RECORD_TYPE_ABORT:
+					lines = append(lines, color.YellowString("    ! stop planning: %s", record.Error))
+				}
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/storage"
+	tuicommon "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+var (
+	playbookStatus2str = map[int]string{
+		comm.PLAYBOOK_STATUS_PENDING: "PENDING",
+		comm.PLAYBOOK_STATUS_RUNNING: "RUNNING",
+		comm.PLAYBOOK_STATUS_SUCCESS: "SUCCESS",
+		comm.PLAYBOOK_STATUS_FAIL:    "FAIL",
+	}
+)
+
+func playbookStatus(status int) tuicommon.DecorateMessage {
+	message := "UNKNOWN"
+	if v, ok := playbookStatus2str[status]; ok {
+		message = v
+	}
+	return tuicommon.DecorateMessage{Message: message, Decorate: statusDecorate}
+}
+
+// FormatPlaybookStatus returns the colored status of playbook run, step or task
+func FormatPlaybookStatus(status int) string {
+	message := playbookStatus(status)
+	return message.Decorate(message.Message)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func formatDuration(start, end time.Time) string {
+	if start.IsZero() || end.IsZero() || end.Before(start) {
+		return "-"
+	}
+	return end.Sub(start).Round(time.Millisecond).String()
+}
+
+func FormatPlaybookRuns(runs []storage.PlaybookRun) string {
+	lines := [][]interface{}{}
+	title := []string{"Id", "Operation", "Status", "Start Time", "Duration", "Audit Id"}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, run := range runs {
+		auditId := "-"
+		if run.AuditId >= 0 {
+			auditId = strconv.FormatInt(run.AuditId, 10)
+		}
+		lines = append(lines, []interface{}{
+			strconv.FormatInt(run.Id, 10),
+			run.Operation,
+			playbookStatus(run.Status),
+			formatTime(run.CreateTime),
+			formatDuration(run.CreateTime, run.EndTime),
+			auditId,
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
+
+func formatPlaybookTasks(tasks []storage.PlaybookTask) string {
+	lines := [][]interface{}{}
+	title := []string{"Host", "Service Id", "Status", "Start Time", "Duration", "Error"}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, task := range tasks {
+		message := strings.Join(strings.Fields(task.Error), " ")
+		lines = append(lines, []interface{}{
+			task.Host,
+			utils.Choose(len(task.ServiceId) > 0, task.ServiceId, "-"),
+			playbookStatus(task.Status),
+			formatTime(task.StartTime),
+			formatDuration(task.StartTime, task.EndTime),
+			utils.Choose(len(message) > 0, message, "-"),
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
+
+func formatPlaybookOutput(task storage.PlaybookTask) string {
+	lines := []string{fmt.Sprintf("+ %s (%s)", task.Host, utils.Choose(len(task.ServiceId) > 0, task.ServiceId, "-"))}
+	for _, output := range []struct{ name, content string }{
+		{"stdout", task.Stdout},
+		{"stderr", task.Stderr},
+	} {
+		if len(output.content) == 0 {
+			continue
+		}
+		lines = append(lines, indentLines(output.name+":", "  "))
+		lines = append(lines, indentLines(output.content, "    "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+/*
+ * [Start Store] FAIL (12.021s)
+ *   Host      Service Id    Status   Start Time           Duration  Error
+ *   ----      ----------    ------   ----------           --------  -----
+ *   10.0.0.1  c2f4a1b3e5d6  SUCCESS  2026-01-02 15:04:05  3.012s    -
+ *   10.0.0.2  91ab03d4c7e2  FAIL     2026-01-02 15:04:05  12.021s   ...
+ *   + 10.0.0.2 (91ab03d4c7e2)
+ *     stderr:
+ *       ...
+ */
+func FormatPlaybookSteps(steps []storage.PlaybookStep, tasks []storage.PlaybookTask, failedOnly, verbose bool) string {
+	step2tasks := map[int][]storage.PlaybookTask{}
+	for _, task := range tasks {
+		if failedOnly && task.Status != comm.PLAYBOOK_STATUS_FAIL {
+			continue
+		}
+		step2tasks[task.StepIndex] = append(step2tasks[task.StepIndex], task)
+	}
+
+	lines := []string{}
+	for _, step := range steps {
+		tasks := step2tasks[step.StepIndex]
+		if failedOnly && len(tasks) == 0 {
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("[%s] %s (%s)", step.Name,
+			FormatPlaybookStatus(step.Status), formatDuration(step.StartTime, step.EndTime)))
+		if len(tasks) == 0 {
+			continue
+		}
+		lines = append(lines, indentLines(formatPlaybookTasks(tasks), "  "))
+		if !verbose {
+			continue
+		}
+		for _, task := range tasks {
+			if len(task.Stdout) > 0 || len(task.Stderr) > 0 {
+				lines = append(lines, indentLines(formatPlaybookOutput(task), "  "))
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -44,6 +44,7 @@ const (
 	ROLE_DINGODB_INDEX    = topology.ROLE_DINGODB_INDEX
 	ROLE_DINGODB_DISKANN  = topology.ROLE_DINGODB_DISKANN
 	ROLE_FS_MDS           = topology.ROLE_FS_MDS
+	ROLE_FS_CACHE         = topology.ROLE_FS_CACHE
 	ROLE_DINGODB_EXECUTOR = topology.ROLE_DINGODB_EXECUTOR
 	ROLE_DINGODB_WEB      = topology.ROLE_DINGODB_WEB
 	ROLE_DINGODB_PROXY    = topology.ROLE_DINGODB_PROXY
@@ -78,6 +79,7 @@ var (
 		ROLE_METASERVER:       4,
 		ROLE_DINGODB_DISKANN:  4,
 		ROLE_DINGODB_EXECUTOR: 5,
+		ROLE_FS_CACHE:         5,
 		ROLE_DINGODB_WEB:      6,
 		ROLE_DINGODB_PROXY:    7,
 	}
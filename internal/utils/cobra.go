@@ -29,6 +29,8 @@ import (
 
 const (
 	PREFIX_COBRA_COMMAND_ERROR = "Error:\n"
+
+	ANNOTATION_DRY_RUN = "dry-run"
 )
 
 var (
@@ -170,3 +172,16 @@ func SetUsageTemplate(cmd *cobra.Command) {
 func SetErr(cmd *cobra.Command, writer io.Writer) {
 	cmd.SetErr(writer)
 }
+
+// SupportDryRun marks the command which all side effects are recorded in --dry-run,
+// e.g. the command only executes playbook, --dry-run is rejected for other commands
+func SupportDryRun(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[ANNOTATION_DRY_RUN] = "true"
+}
+
+func IsDryRunSupported(cmd *cobra.Command) bool {
+	return cmd.Annotations[ANNOTATION_DRY_RUN] == "true"
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+var cronMacros = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+	"@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// CheckCronSchedule only checks the shape of schedule, crond reports the invalid value itself
+func CheckCronSchedule(schedule string) error {
+	if cronMacros[schedule] {
+		return nil
+	}
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return fmt.Errorf("invalid schedule '%s', requires 5 cron fields (e.g. '0 6 * * *') or macro like @daily", schedule)
+	}
+	for _, field := range fields {
+		if !isCronField(field) {
+			return fmt.Errorf("invalid schedule field '%s'", field)
+		}
+	}
+	return nil
+}
+
+// digits, ranges, steps and names of month or weekday, e.g. */15, 1-5, mon-fri
+func isCronField(field string) bool {
+	for _, c := range strings.ToLower(field) {
+		if (c < 'a' || c > 'z') && !strings.ContainsRune("0123456789*/,-", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateCrontab replaces the crontab line tagged with tag, removes it if line is empty
+func UpdateCrontab(content, tag, line string) string {
+	lines := []string{}
+	for _, l := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+		if l == "" && len(lines) == 0 {
+			continue
+		} else if strings.HasSuffix(l, tag) {
+			continue
+		}
+		lines = append(lines, l)
+	}
+	if len(line) > 0 {
+		lines = append(lines, fmt.Sprintf("%s %s", line, tag))
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func ReadCrontab() (string, error) {
+	out, err := exec.Command("crontab", "-l").CombinedOutput()
+	if err != nil {
+		// crontab exits with error if the user has no crontab yet
+		if strings.Contains(string(out), "no crontab") {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s: %v", strings.TrimSpace(string(out)), err)
+	}
+	return string(out), nil
+}
+
+func WriteCrontab(content string) error {
+	cmd := exec.Command("crontab", "-")
+	cmd.Stdin = strings.NewReader(content)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s: %v", strings.TrimSpace(string(out)), err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateCrontab(t *testing.T) {
+	assert := assert.New(t)
+
+	// entries of different tags share one crontab
+	content := "\n0 1 * * * /usr/bin/logrotate"
+	content = UpdateCrontab(content, "# warmup:daily", "0 6 * * * dingo fs warmup profile run daily")
+	content = UpdateCrontab(content, "# backup:c1", "@daily dingo cluster backup")
+	assert.Equal("0 1 * * * /usr/bin/logrotate\n"+
+		"0 6 * * * dingo fs warmup profile run daily # warmup:daily\n"+
+		"@daily dingo cluster backup # backup:c1\n", content)
+
+	// replace one tag, the others are untouched
+	content = UpdateCrontab(content, "# backup:c1", "0 2 * * * dingo cluster backup")
+	assert.Equal("0 1 * * * /usr/bin/logrotate\n"+
+		"0 6 * * * dingo fs warmup profile run daily # warmup:daily\n"+
+		"0 2 * * * dingo cluster backup # backup:c1\n", content)
+
+	// remove, the tag must match the end of line
+	content = UpdateCrontab(content, "# backup:c", "")
+	content = UpdateCrontab(content, "# warmup:daily", "")
+	assert.Equal("0 1 * * * /usr/bin/logrotate\n"+
+		"0 2 * * * dingo cluster backup # backup:c1\n", content)
+
+	content = UpdateCrontab(content, "# backup:c1", "")
+	assert.Equal("0 1 * * * /usr/bin/logrotate\n", content)
+	assert.Equal("", UpdateCrontab("", "# backup:c1", ""))
+	assert.Equal("@hourly sync # backup:c1\n", UpdateCrontab("", "# backup:c1", "@hourly sync"))
+}
+
+func TestCheckCronSchedule(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, schedule := range []string{
+		"0 6 * * *",
+		"*/15 8-18 * jan-jun mon-fri",
+		"0,30 * 1 * SUN",
+		"@daily",
+		"@annually",
+	} {
+		assert.NoError(CheckCronSchedule(schedule), schedule)
+	}
+
+	for _, schedule := range []string{
+		"",
+		"0 6 * *",
+		"0 6 * * * *",
+		"@every 1h",
+		"0 6 * * * ; rm -rf /",
+		"0 6 * * $(id)",
+		"0 6 * * `id`",
+	} {
+		assert.Error(CheckCronSchedule(schedule), schedule)
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/schollz/progressbar/v3"
@@ -132,6 +134,16 @@ func AddExecutePermission(filepath string) error {
 	return os.Chmod(filepath, newMode)
 }
 
+// LocalSourcePath returns the local path of source, e.g. "file:///data/mirror" or "/data/mirror"
+func LocalSourcePath(source string) (string, bool) {
+	if strings.HasPrefix(source, "file://") {
+		return strings.TrimPrefix(source, "file://"), true
+	} else if strings.HasPrefix(source, "/") {
+		return source, true
+	}
+	return "", false
+}
+
 func DownloadFileWithProgress(url, destination, filename string) error {
 	// resp, err := http.Get(url)
 	// if err != nil {
@@ -139,6 +151,21 @@ func DownloadFileWithProgress(url, destination, filename string) error {
 	// }
 	// defer resp.Body.Close()
 
+	// local mirror
+	if path, ok := LocalSourcePath(url); ok {
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		info, err := in.Stat()
+		if err != nil {
+			return err
+		}
+		return saveFileWithProgress(in, info.Size(), url, destination, filename)
+	}
+
 	client := &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{
@@ -166,6 +193,14 @@ func DownloadFileWithProgress(url, destination, filename string) error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP request failed with status: %s, url: %s", resp.Status, url)
+	}
+
+	return saveFileWithProgress(resp.Body, resp.ContentLength, url, destination, filename)
+}
+
+func saveFileWithProgress(reader io.Reader, length int64, url, destination, filename string) error {
 	tmpFileName := filename
 	if tmpFileName == "" {
 		tmpFileName = filepath.Base(url)
@@ -184,7 +219,7 @@ func DownloadFileWithProgress(url, destination, filename string) error {
 	defer out.Close()
 
 	bar := progressbar.NewOptions64(
-		resp.ContentLength,
+		length,
 		progressbar.OptionSetDescription(fmt.Sprintf("[cyan]Downloading[reset] %s...", filename)),
 		progressbar.OptionSetWriter(os.Stderr),
 		progressbar.OptionShowBytes(true),
@@ -203,7 +238,7 @@ func DownloadFileWithProgress(url, destination, filename string) error {
 		}),
 	)
 
-	_, err = io.Copy(io.MultiWriter(out, bar), resp.Body)
+	_, err = io.Copy(io.MultiWriter(out, bar), reader)
 	if err != nil {
 		os.Remove(filePath)
 		return err
@@ -219,6 +254,14 @@ func DownloadFileWithProgress(url, destination, filename string) error {
 }
 
 func GetRemoteFileContent(url string) (string, error) {
+	if path, ok := LocalSourcePath(url); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("read file failed: %w, url: %s", err, url)
+		}
+		return string(data), nil
+	}
+
 	client := &http.Client{
 		Timeout: 30 * time.Second,
 	}
@@ -239,3 +282,26 @@ func GetRemoteFileContent(url string) (string, error) {
 
 	return string(body), nil
 }
+
+// ParseMetricValue parses the output of brpc vars, e.g. "dingofs_remote_cache_hit_count : 1024"
+func ParseMetricValue(out string) (float64, error) {
+	out = strings.TrimSpace(out)
+	if index := strings.LastIndex(out, ":"); index >= 0 {
+		out = strings.TrimSpace(out[index+1:])
+	}
+	return strconv.ParseFloat(out, 64)
+}
+
+// GetMetricValue reads the brpc var of the service which listens on ip:port
+func GetMetricValue(ip string, port uint32, name string) (float64, error) {
+	url := fmt.Sprintf("http://%s:%d/vars/%s?console=1", ip, port, name)
+	out, err := GetRemoteFileContent(url)
+	if err != nil {
+		return 0, err
+	}
+	value, err := ParseMetricValue(out)
+	if err != nil {
+		return 0, fmt.Errorf("invalid metric value: %s, url: %s", strings.TrimSpace(out), url)
+	}
+	return value, nil
+}
@@ -14,3 +14,18 @@ func TestVariantName(t *testing.T) {
 	assert.Equal("test.local.tar.gz", vname.LocalCompressName)
 	assert.Equal("test-encrypted.tar.gz", vname.EncryptCompressName)
 }
+
+func TestParseMetricValue(t *testing.T) {
+	assert := assert.New(t)
+
+	value, err := ParseMetricValue("dingofs_remote_cache_hit_count : 1024\n")
+	assert.NoError(err)
+	assert.Equal(float64(1024), value)
+
+	value, err = ParseMetricValue("12.5")
+	assert.NoError(err)
+	assert.Equal(12.5, value)
+
+	_, err = ParseMetricValue("not found")
+	assert.Error(err)
+}
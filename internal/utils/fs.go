@@ -46,24 +46,13 @@ func GetFileInode(path string) (uint64, error) {
 }
 
 func GetInodesAsString(listFilePath string) (string, error) {
-	content, err := os.ReadFile(listFilePath)
+	filePaths, err := ReadFileList(listFilePath)
 	if err != nil {
-		return "", fmt.Errorf("failed to read file list: %v", err)
+		return "", err
 	}
 
-	lines := strings.Split(string(content), "\n")
 	var inodeStrings []string
-
-	for _, line := range lines {
-		filePath := strings.TrimSpace(line)
-		if filePath == "" {
-			continue
-		}
-
-		if !strings.HasPrefix(filePath, "/") {
-			return "", fmt.Errorf("filelist[%s] content error, each line requires a full path name", listFilePath)
-		}
-
+	for _, filePath := range filePaths {
 		inodeId, err2 := GetFileInode(filePath)
 		if err2 != nil {
 			return "", fmt.Errorf("%s not exist", filePath)
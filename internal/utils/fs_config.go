@@ -413,11 +413,19 @@ func AddFormatFlag(cmd *cobra.Command) {
 	}
 }
 
+// getSpecifiedConfigFile returns the configuration file specified by user,
+// command line (--conf dingo.yaml) > environment variables(CONF=/opt/dingo.yaml),
+// empty means the default one
+func getSpecifiedConfigFile(cmd *cobra.Command) string {
+	if flag := cmd.Flag("conf"); flag != nil && flag.Changed {
+		return flag.Value.String()
+	}
+	return os.Getenv("CONF") //check environment variable
+}
+
 func GetConfigFile(cmd *cobra.Command) string {
-	var value string
-	if cmd.Flag("conf").Changed {
-		value = cmd.Flag("conf").Value.String()
-	} else {
+	value := getSpecifiedConfigFile(cmd)
+	if value == "" {
 		// using $HOME/.dingo/dingo.yaml as default configuration file path
 		home, err := os.UserHomeDir()
 		cobra.CheckErr(err)
@@ -430,13 +438,7 @@ func GetConfigFile(cmd *cobra.Command) string {
 func ReadCommandConfig(cmd *cobra.Command) {
 	// configure file priority
 	// command line (--conf dingo.yaml) > environment variables(CONF=/opt/dingo.yaml) > default (~/.dingo/dingo.yaml)
-	var value string
-	if cmd.Flag("conf").Changed {
-		value = cmd.Flag("conf").Value.String()
-	} else {
-		value = os.Getenv("CONF") //check environment variable
-	}
-
+	value := getSpecifiedConfigFile(cmd)
 	if value != "" {
 		viper.SetConfigFile(value)
 	} else { // use default
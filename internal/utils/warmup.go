@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+)
+
+type WarmupFile struct {
+	Path       string
+	Inode      uint64
+	Size       int64
+	ModTime    time.Time
+	MountPoint string
+}
+
+type WarmupFilter struct {
+	Includes  []string  // glob patterns, match all files if empty
+	Excludes  []string  // glob patterns
+	NewerThan time.Time // zero means no limit
+	MaxBytes  uint64    // 0 means no limit
+}
+
+// pattern which contains "/" matches the path relative to the walked
+// root, otherwise it matches the file name
+func matchGlob(pattern, relpath string) bool {
+	name := relpath
+	if !strings.Contains(pattern, "/") {
+		name = filepath.Base(relpath)
+	}
+	matched, _ := filepath.Match(pattern, name)
+	return matched
+}
+
+func (f *WarmupFilter) Match(relpath string, info fs.FileInfo) bool {
+	if !f.NewerThan.IsZero() && info.ModTime().Before(f.NewerThan) {
+		return false
+	}
+	for _, pattern := range f.Excludes {
+		if matchGlob(pattern, relpath) {
+			return false
+		}
+	}
+	if len(f.Includes) == 0 {
+		return true
+	}
+	for _, pattern := range f.Includes {
+		if matchGlob(pattern, relpath) {
+			return true
+		}
+	}
+	return false
+}
+
+func ValidateGlobs(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid glob pattern '%s': %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// find the dingofs mountpoint which the path belongs to (longest match)
+func FindMountPoint(path string, mountpoints []*mountinfo.MountInfo) *mountinfo.MountInfo {
+	var found *mountinfo.MountInfo
+	for _, m := range mountpoints {
+		mp := m.MountPoint
+		if path != mp && !strings.HasPrefix(path, strings.TrimSuffix(mp, "/")+"/") {
+			continue
+		}
+		if found == nil || len(mp) > len(found.MountPoint) {
+			found = m
+		}
+	}
+	return found
+}
+
+// read the paths in filelist, each line requires a full path name
+func ReadFileList(listFilePath string) ([]string, error) {
+	content, err := os.ReadFile(listFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file list: %v", err)
+	}
+
+	paths := []string{}
+	for _, line := range strings.Split(string(content), "\n") {
+		filePath := strings.TrimSpace(line)
+		if filePath == "" {
+			continue
+		}
+		if !strings.HasPrefix(filePath, "/") {
+			return nil, fmt.Errorf("filelist[%s] content error, each line requires a full path name", listFilePath)
+		}
+		paths = append(paths, filePath)
+	}
+	return paths, nil
+}
+
+// walk the paths recursively and collect the regular files which match the filter
+func CollectWarmupFiles(paths []string, mountpoints []*mountinfo.MountInfo, filter *WarmupFilter) ([]*WarmupFile, error) {
+	files := []*WarmupFile{}
+	visited := map[string]bool{} // inode number is only unique in one filesystem
+	for _, root := range paths {
+		root = filepath.Clean(AbsPath(root))
+		mountpoint := FindMountPoint(root, mountpoints)
+		if mountpoint == nil {
+			return nil, fmt.Errorf("[%s] is not saved in dingofs", root)
+		}
+
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			} else if !d.Type().IsRegular() {
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			relpath, _ := filepath.Rel(root, path)
+			if relpath == "." {
+				relpath = filepath.Base(path)
+			}
+			if !filter.Match(relpath, info) {
+				return nil
+			}
+
+			sst, ok := info.Sys().(*syscall.Stat_t)
+			if !ok || sst.Ino == 0 {
+				return nil
+			}
+			key := fmt.Sprintf("%s:%d", mountpoint.MountPoint, sst.Ino)
+			if visited[key] {
+				return nil
+			}
+			visited[key] = true
+			files = append(files, &WarmupFile{
+				Path:       path,
+				Inode:      sst.Ino,
+				Size:       info.Size(),
+				ModTime:    info.ModTime(),
+				MountPoint: mountpoint.MountPoint,
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("walk [%s] failed: %v", root, err)
+		}
+	}
+
+	return ApplyWarmupBudget(files, filter.MaxBytes), nil
+}
+
+// pick the most recently modified files first until the budget is used up,
+// files which exceed the remaining budget are skipped
+func ApplyWarmupBudget(files []*WarmupFile, maxBytes uint64) []*WarmupFile {
+	if maxBytes == 0 {
+		return files
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].ModTime.After(files[j].ModTime)
+	})
+
+	picked := []*WarmupFile{}
+	var used uint64
+	for _, file := range files {
+		size := uint64(file.Size)
+		if used+size > maxBytes {
+			continue
+		}
+		used += size
+		picked = append(picked, file)
+	}
+	return picked
+}
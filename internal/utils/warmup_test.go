@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindMountPoint(t *testing.T) {
+	assert := assert.New(t)
+	mountpoints := []*mountinfo.MountInfo{
+		{MountPoint: "/mnt/fs"},
+		{MountPoint: "/mnt/fs/nested"},
+	}
+	assert.Equal("/mnt/fs", FindMountPoint("/mnt/fs/a/b", mountpoints).MountPoint)
+	assert.Equal("/mnt/fs", FindMountPoint("/mnt/fs", mountpoints).MountPoint)
+	assert.Equal("/mnt/fs/nested", FindMountPoint("/mnt/fs/nested/c", mountpoints).MountPoint)
+	assert.Nil(FindMountPoint("/mnt/fs2/a", mountpoints))
+}
+
+func TestApplyWarmupBudget(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	files := []*WarmupFile{
+		{Path: "old", Size: 10, ModTime: now.Add(-3 * time.Hour)},
+		{Path: "new", Size: 60, ModTime: now.Add(-1 * time.Hour)},
+		{Path: "mid", Size: 50, ModTime: now.Add(-2 * time.Hour)},
+	}
+
+	picked := ApplyWarmupBudget(files, 100)
+	assert.Len(picked, 2)
+	assert.Equal("new", picked[0].Path)
+	assert.Equal("old", picked[1].Path)
+
+	assert.Len(ApplyWarmupBudget(files, 0), 3)
+}
+
+func TestCollectWarmupFiles(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	os.MkdirAll(filepath.Join(root, "a", "b"), 0755)
+	os.WriteFile(filepath.Join(root, "a", "1.parquet"), []byte("1"), 0644)
+	os.WriteFile(filepath.Join(root, "a", "b", "2.parquet"), []byte("22"), 0644)
+	os.WriteFile(filepath.Join(root, "a", "b", "3.tmp"), []byte("333"), 0644)
+	old := filepath.Join(root, "a", "4.parquet")
+	os.WriteFile(old, []byte("4444"), 0644)
+	os.Chtimes(old, time.Now().AddDate(0, 0, -10), time.Now().AddDate(0, 0, -10))
+
+	mountpoints := []*mountinfo.MountInfo{{MountPoint: root}}
+	filter := &WarmupFilter{
+		Includes:  []string{"*.parquet", "*.tmp"},
+		Excludes:  []string{"b/*.tmp"},
+		NewerThan: time.Now().AddDate(0, 0, -1),
+	}
+	files, err := CollectWarmupFiles([]string{filepath.Join(root, "a")}, mountpoints, filter)
+	assert.NoError(err)
+	assert.Len(files, 2)
+	for _, file := range files {
+		assert.Equal(root, file.MountPoint)
+		assert.NotZero(file.Inode)
+	}
+
+	_, err = CollectWarmupFiles([]string{"/not/in/dingofs"}, mountpoints, filter)
+	assert.Error(err)
+}
+
+func TestCollectWarmupFilesDedupe(t *testing.T) {
+	assert := assert.New(t)
+	root1, root2 := t.TempDir(), t.TempDir()
+	file := filepath.Join(root1, "1.parquet")
+	os.WriteFile(file, []byte("1"), 0644)
+	assert.NoError(os.Link(file, filepath.Join(root1, "2.parquet")))
+	assert.NoError(os.Link(file, filepath.Join(root2, "1.parquet")))
+
+	// same inode number in different mountpoints are different files
+	mountpoints := []*mountinfo.MountInfo{{MountPoint: root1}, {MountPoint: root2}}
+	files, err := CollectWarmupFiles([]string{root1, root2}, mountpoints, &WarmupFilter{})
+	assert.NoError(err)
+	assert.Len(files, 2)
+	assert.Equal(root1, files[0].MountPoint)
+	assert.Equal(root2, files[1].MountPoint)
+	assert.Equal(files[0].Inode, files[1].Inode)
+}
@@ -43,6 +43,8 @@ const (
 
 type DockerCli struct {
 	sshClient *SSHClient
+	recorder  *TaskRecorder
+	output    *Output
 	options   []string
 	tmpl      *template.Template
 	data      map[string]interface{}
@@ -63,9 +65,14 @@ func (s *DockerCli) AddOption(format string, args ...interface{}) *DockerCli {
 }
 
 func (cli *DockerCli) Execute(options ExecOptions) (string, error) {
+	engine := GetEngine(options.ExecWithEngine)
+	if engine.Rootless {
+		options.ExecWithSudo = false
+	}
 	cli.data["options"] = strings.Join(cli.options, " ")
-	cli.data["engine"] = options.ExecWithEngine
-	return execCommand(cli.sshClient, cli.tmpl, cli.data, options)
+	cli.data["engine"] = engine.Binary
+	out, err := execCommand(cli.sshClient, cli.recorder, cli.output, cli.tmpl, cli.data, options)
+	return engine.Trim(out), err
 }
 
 func (cli *DockerCli) DockerInfo() *DockerCli {
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	ENGINE_DOCKER          = "docker"
+	ENGINE_PODMAN          = "podman"
+	ENGINE_PODMAN_ROOTLESS = "podman-rootless"
+	ENGINE_NERDCTL         = "nerdctl"
+
+	// ulimit value which let podman inherit the limits of the caller
+	ULIMIT_HOST = "host"
+)
+
+// Engine adapts the docker-style commands to a container engine
+type Engine struct {
+	Name     string // name in dingocli.cfg
+	Binary   string // command to invoke, e.g. podman
+	Rootless bool   // engine commands run as the ssh user, without sudo
+	Init     bool   // support `create --init`
+	// rootless engine can't raise the limits beyond the caller's, so it inherits them
+	HostUlimit bool
+	// `exec --tty` requires stdin kept open by `--interactive`
+	TtyWithStdin bool
+	// value of `logs --tail` which shows all lines, empty means "all"
+	TailAll string
+	// docker inspect/ps format => engine format
+	Formats map[string]string
+	// log lines the engine prints into the output of every command
+	Noise *regexp.Regexp
+}
+
+var (
+	// podman and nerdctl print logrus-style warnings, e.g.
+	//   time="2026-01-01T00:00:00Z" level=warning msg="..."
+	//   WARN[0000] The cgroupv2 manager is set to systemd but there is no systemd user session available
+	LOGRUS_NOISE = regexp.MustCompile(`^(time="[^"]*" level=(warning|info|debug)|(WARN|INFO|DEBU)\[\d+\]) `)
+
+	ENGINES = map[string]*Engine{
+		ENGINE_DOCKER: {
+			Name:   ENGINE_DOCKER,
+			Binary: "docker",
+			Init:   true,
+		},
+		ENGINE_PODMAN: {
+			Name:    ENGINE_PODMAN,
+			Binary:  "podman",
+			Init:    true,
+			TailAll: "-1", // podman only accepts number
+			Noise:   LOGRUS_NOISE,
+		},
+		ENGINE_PODMAN_ROOTLESS: {
+			Name:       ENGINE_PODMAN_ROOTLESS,
+			Binary:     "podman",
+			Rootless:   true,
+			Init:       true,
+			HostUlimit: true,
+			TailAll:    "-1",
+			Noise:      LOGRUS_NOISE,
+		},
+		ENGINE_NERDCTL: {
+			Name:         ENGINE_NERDCTL,
+			Binary:       "nerdctl",
+			Init:         true,
+			TtyWithStdin: true,
+			// nerdctl keeps the image reference in `.Image` of its docker-compatible inspect output
+			Formats: map[string]string{
+				"{{.Config.Image}}": "{{.Image}}",
+			},
+			Noise: LOGRUS_NOISE,
+		},
+	}
+)
+
+func IsSupportedEngine(name string) bool {
+	_, ok := ENGINES[name]
+	return ok
+}
+
+// GetEngine returns the adapter of engine, the unknown engine is treated as docker-compatible
+func GetEngine(name string) *Engine {
+	if engine, ok := ENGINES[name]; ok {
+		return engine
+	}
+	if len(name) == 0 {
+		return ENGINES[ENGINE_DOCKER]
+	}
+	return &Engine{Name: name, Binary: name, Init: true}
+}
+
+func SupportedEngines() []string {
+	return []string{ENGINE_DOCKER, ENGINE_PODMAN, ENGINE_PODMAN_ROOTLESS, ENGINE_NERDCTL}
+}
+
+// Format rewrites the docker format template for engine
+func (e *Engine) Format(format string) string {
+	for from, to := range e.Formats {
+		format = strings.ReplaceAll(format, from, to)
+	}
+	return format
+}
+
+// Ulimits returns the ulimit options for `create`
+func (e *Engine) Ulimits(ulimits []string) []string {
+	if e.HostUlimit && len(ulimits) > 0 {
+		return []string{ULIMIT_HOST}
+	}
+	return ulimits
+}
+
+// ExecFlags returns the options for `exec`, the command runs as user if specified
+func (e *Engine) ExecFlags(interactive, tty bool, user string) []string {
+	flags := []string{}
+	if interactive || (tty && e.TtyWithStdin) {
+		flags = append(flags, "--interactive")
+	}
+	if tty {
+		flags = append(flags, "--tty")
+	}
+	if len(user) > 0 {
+		flags = append(flags, "--user "+user)
+	}
+	return flags
+}
+
+// LogsFlags returns the options for `logs`, tail <= 0 shows all lines
+func (e *Engine) LogsFlags(tail int) []string {
+	if tail > 0 {
+		return []string{fmt.Sprintf("--tail %d", tail)}
+	} else if len(e.TailAll) > 0 {
+		return []string{"--tail " + e.TailAll}
+	}
+	return []string{"--tail all"}
+}
+
+// Trim removes the engine's own log lines from command output,
+// so callers which take the last line (e.g. container id) get the right one
+func (e *Engine) Trim(out string) string {
+	if e.Noise == nil || len(out) == 0 {
+		return out
+	}
+
+	lines := []string{}
+	for _, line := range strings.Split(out, "\n") {
+		if !e.Noise.MatchString(line) {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEngine(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("docker", GetEngine("").Binary)
+	assert.Equal("podman", GetEngine(ENGINE_PODMAN_ROOTLESS).Binary)
+	assert.True(GetEngine(ENGINE_PODMAN_ROOTLESS).Rootless)
+	assert.False(IsSupportedEngine("containerd"))
+	assert.Equal("containerd", GetEngine("containerd").Binary)
+}
+
+func TestEngineAdapter(t *testing.T) {
+	assert := assert.New(t)
+
+	docker, rootless, nerdctl := GetEngine(ENGINE_DOCKER), GetEngine(ENGINE_PODMAN_ROOTLESS), GetEngine(ENGINE_NERDCTL)
+	assert.Equal(`"{{.Config.Image}}"`, docker.Format(`"{{.Config.Image}}"`))
+	assert.Equal(`"{{.Image}}"`, nerdctl.Format(`"{{.Config.Image}}"`))
+	assert.Equal(`'{{.State.Status}}'`, nerdctl.Format(`'{{.State.Status}}'`))
+
+	ulimits := []string{"nofile=1048576:1048576", "core=-1"}
+	assert.Equal(ulimits, docker.Ulimits(ulimits))
+	assert.Equal([]string{ULIMIT_HOST}, rootless.Ulimits(ulimits))
+
+	out := "WARN[0000] The cgroupv2 manager is set to systemd but there is no systemd user session available\n" +
+		"3f4e5d6c7b8a\n" +
+		`time="2026-01-01T00:00:00Z" level=warning msg="cannot set ulimit"`
+	assert.Equal("3f4e5d6c7b8a", rootless.Trim(out))
+	assert.Equal(out, docker.Trim(out))
+}
+
+func TestRecordRootlessEngine(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := NewRecorder()
+	sshClient := NewOfflineSSHClient(SSHConfig{User: "dingo", Host: "10.0.0.1", Port: 22})
+	m := NewRecordModule(sshClient, recorder.ForTask("Pull Image", "host=10.0.0.1", "10.0.0.1"))
+	_, err := m.DockerCli().PullImage("dingofs:latest").Execute(ExecOptions{
+		ExecWithSudo:   true,
+		ExecWithEngine: ENGINE_PODMAN_ROOTLESS,
+	})
+	assert.Nil(err)
+	_, err = m.DockerCli().PullImage("dingofs:latest").Execute(ExecOptions{
+		ExecWithSudo:   true,
+		ExecWithEngine: ENGINE_NERDCTL,
+	})
+	assert.Nil(err)
+
+	records := recorder.Records()
+	assert.Len(records, 2)
+	assert.Equal("podman pull  dingofs:latest", records[0].Command)
+	assert.Equal("sudo nerdctl pull  dingofs:latest", records[1].Command)
+}
+
+func TestEngineExecFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tc := range []struct {
+		engine      string
+		interactive bool
+		tty         bool
+		user        string
+		expected    []string
+	}{
+		{ENGINE_DOCKER, true, true, "", []string{"--interactive", "--tty"}},
+		{ENGINE_DOCKER, false, true, "", []string{"--tty"}},
+		{ENGINE_DOCKER, false, false, "0:0", []string{"--user 0:0"}},
+		{ENGINE_PODMAN, false, true, "", []string{"--tty"}},
+		{ENGINE_PODMAN_ROOTLESS, false, false, "0:0", []string{"--user 0:0"}},
+		{ENGINE_NERDCTL, false, true, "", []string{"--interactive", "--tty"}},
+		{ENGINE_NERDCTL, false, false, "", []string{}},
+	} {
+		assert.Equal(tc.expected, GetEngine(tc.engine).ExecFlags(tc.interactive, tc.tty, tc.user), tc.engine)
+	}
+}
+
+func TestEngineLogsFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, engine := range SupportedEngines() {
+		assert.Equal([]string{"--tail 100"}, GetEngine(engine).LogsFlags(100), engine)
+	}
+	assert.Equal([]string{"--tail all"}, GetEngine(ENGINE_DOCKER).LogsFlags(0))
+	assert.Equal([]string{"--tail -1"}, GetEngine(ENGINE_PODMAN).LogsFlags(0))
+	assert.Equal([]string{"--tail -1"}, GetEngine(ENGINE_PODMAN_ROOTLESS).LogsFlags(-1))
+	assert.Equal([]string{"--tail all"}, GetEngine(ENGINE_NERDCTL).LogsFlags(0))
+}
+
+func TestRecordEngineLogsAndExec(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := NewRecorder()
+	sshClient := NewOfflineSSHClient(SSHConfig{User: "dingo", Host: "10.0.0.1", Port: 22})
+	m := NewRecordModule(sshClient, recorder.ForTask("Get Logs", "host=10.0.0.1", "10.0.0.1"))
+	for _, name := range []string{ENGINE_PODMAN, ENGINE_NERDCTL} {
+		engine := GetEngine(name)
+		cli := m.DockerCli().ContainerLogs("3f4e5d6c7b8a")
+		for _, flag := range engine.LogsFlags(0) {
+			cli.AddOption("%s", flag)
+		}
+		_, err := cli.Execute(ExecOptions{ExecWithEngine: name})
+		assert.Nil(err)
+
+		cli = m.DockerCli().ContainerExec("3f4e5d6c7b8a", "ls")
+		for _, flag := range engine.ExecFlags(false, true, "") {
+			cli.AddOption("%s", flag)
+		}
+		_, err = cli.Execute(ExecOptions{ExecWithEngine: name})
+		assert.Nil(err)
+	}
+
+	records := recorder.Records()
+	assert.Len(records, 4)
+	assert.Equal("podman logs --tail -1 3f4e5d6c7b8a", records[0].Command)
+	assert.Equal("podman exec --tty 3f4e5d6c7b8a ls", records[1].Command)
+	assert.Equal("nerdctl logs --tail all 3f4e5d6c7b8a", records[2].Command)
+	assert.Equal("nerdctl exec --interactive --tty 3f4e5d6c7b8a ls", records[3].Command)
+}
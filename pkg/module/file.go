@@ -34,6 +34,7 @@ var (
 
 type FileManager struct {
 	sshClient *SSHClient
+	recorder  *TaskRecorder
 }
 
 func NewFileManager(sshClient *SSHClient) *FileManager {
@@ -41,7 +42,10 @@ func NewFileManager(sshClient *SSHClient) *FileManager {
 }
 
 func (f *FileManager) Upload(localPath, remotePath string) error {
-	if f.sshClient == nil {
+	if f.recorder != nil {
+		f.recorder.Upload(localPath, remotePath)
+		return nil
+	} else if f.sshClient == nil {
 		return ERR_UNREACHED
 	}
 
@@ -55,7 +59,9 @@ func (f *FileManager) Upload(localPath, remotePath string) error {
 }
 
 func (f *FileManager) Download(remotePath, localPath string) error {
-	if f.sshClient == nil {
+	if f.recorder != nil {
+		return f.recorder.Download(remotePath, localPath)
+	} else if f.sshClient == nil {
 		return ERR_UNREACHED
 	}
 
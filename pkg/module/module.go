@@ -32,6 +32,8 @@ import (
 type (
 	Module struct {
 		sshClient *SSHClient
+		recorder  *TaskRecorder
+		output    *Output
 	}
 
 	ExecOptions struct {
@@ -56,16 +58,35 @@ func NewModule(sshClient *SSHClient) *Module {
 	return &Module{sshClient: sshClient}
 }
 
+// NewRecordModule returns a module which records commands and files instead of executing them,
+// the ssh client is only used for its config and never connected
+func NewRecordModule(sshClient *SSHClient, recorder *TaskRecorder) *Module {
+	return &Module{sshClient: sshClient, recorder: recorder}
+}
+
+// NewCaptureModule returns a module which captures the stdout and stderr of executed commands into output
+func NewCaptureModule(sshClient *SSHClient, output *Output) *Module {
+	return &Module{sshClient: sshClient, output: output}
+}
+
 func (m *Module) Shell() *Shell {
-	return NewShell(m.sshClient)
+	shell := NewShell(m.sshClient)
+	shell.recorder = m.recorder
+	shell.output = m.output
+	return shell
 }
 
 func (m *Module) File() *FileManager {
-	return NewFileManager(m.sshClient)
+	file := NewFileManager(m.sshClient)
+	file.recorder = m.recorder
+	return file
 }
 
 func (m *Module) DockerCli() *DockerCli {
-	return NewDockerCli(m.sshClient)
+	cli := NewDockerCli(m.sshClient)
+	cli.recorder = m.recorder
+	cli.output = m.output
+	return cli
 }
 
 // common utils
@@ -79,6 +100,8 @@ func remoteAddr(client *SSHClient) string {
 }
 
 func execCommand(sshClient *SSHClient,
+	recorder *TaskRecorder,
+	output *Output,
 	tmpl *template.Template,
 	data map[string]interface{},
 	options ExecOptions) (string, error) {
@@ -110,7 +133,13 @@ func execCommand(sshClient *SSHClient,
 		}
 	}
 
-	// (4) create context for timeout
+	// (4) record command for dry-run
+	if recorder != nil {
+		recorder.Command(command, options)
+		return "", nil
+	}
+
+	// (5) create context for timeout
 	ctx := context.Background()
 	if options.ExecTimeoutSec > 0 {
 		var cancel context.CancelFunc
@@ -118,20 +147,24 @@ func execCommand(sshClient *SSHClient,
 		defer cancel()
 	}
 
-	// (5) execute command
-	var out []byte
+	// (6) execute command
 	var err error
+	stdout, stderr := newOutputWriters()
 	if options.ExecInLocal {
 		cmd := exec.CommandContext(ctx, "bash", "-c", command)
 		cmd.Env = []string{"LANG=en_US.UTF-8"}
-		out, err = cmd.CombinedOutput()
+		cmd.Stdout, cmd.Stderr = stdout, stderr
+		err = cmd.Run()
 	} else {
 		var cmd *goph.Cmd
 		cmd, err = sshClient.Client().CommandContext(ctx, command)
 		if err == nil {
-			out, err = cmd.CombinedOutput()
+			cmd.Stdout, cmd.Stderr = stdout, stderr
+			err = cmd.Run()
 		}
 	}
+	out := stdout.Combined()
+	output.append(stdout.Bytes(), stderr.Bytes())
 
 	if ctx.Err() == context.DeadlineExceeded {
 		err = &TimeoutError{options.ExecTimeoutSec}
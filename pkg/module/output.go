@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"bytes"
+	"sync"
+)
+
+const (
+	// only the tail of output is kept for each task
+	OUTPUT_LIMIT = 64 * 1024
+)
+
+type (
+	// Output captures the stdout and stderr of all commands executed by one task
+	Output struct {
+		mutex  sync.Mutex
+		stdout []byte
+		stderr []byte
+	}
+
+	// outputWriter writes into its own buffer and the combined buffer shared with
+	// the other stream, which keeps the order of stdout and stderr
+	outputWriter struct {
+		mutex    *sync.Mutex
+		buffer   *bytes.Buffer
+		combined *bytes.Buffer
+	}
+)
+
+func NewOutput() *Output {
+	return &Output{}
+}
+
+func tail(data []byte) []byte {
+	if len(data) > OUTPUT_LIMIT {
+		return data[len(data)-OUTPUT_LIMIT:]
+	}
+	return data
+}
+
+func (o *Output) append(stdout, stderr []byte) {
+	if o == nil {
+		return
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.stdout = tail(append(o.stdout, stdout...))
+	o.stderr = tail(append(o.stderr, stderr...))
+}
+
+func (o *Output) Stdout() string {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return string(o.stdout)
+}
+
+func (o *Output) Stderr() string {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return string(o.stderr)
+}
+
+func newOutputWriters() (*outputWriter, *outputWriter) {
+	mutex := &sync.Mutex{}
+	combined := &bytes.Buffer{}
+	stdout := &outputWriter{mutex: mutex, buffer: &bytes.Buffer{}, combined: combined}
+	stderr := &outputWriter{mutex: mutex, buffer: &bytes.Buffer{}, combined: combined}
+	return stdout, stderr
+}
+
+func (w *outputWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.buffer.Write(p)
+	return w.combined.Write(p)
+}
+
+func (w *outputWriter) Bytes() []byte {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return append([]byte{}, w.buffer.Bytes()...)
+}
+
+func (w *outputWriter) Combined() []byte {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return append([]byte{}, w.combined.Bytes()...)
+}
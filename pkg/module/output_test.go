@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureModule(t *testing.T) {
+	assert := assert.New(t)
+
+	output := NewOutput()
+	m := NewCaptureModule(nil, output)
+	out, err := m.Shell().Command("echo hello; echo world >&2").Execute(ExecOptions{ExecInLocal: true})
+	assert.Nil(err)
+	assert.ElementsMatch([]string{"hello", "world"}, strings.Fields(out))
+	_, err = m.Shell().Command("echo failed >&2; exit 1").Execute(ExecOptions{ExecInLocal: true})
+	assert.NotNil(err)
+
+	assert.Equal("hello\n", output.Stdout())
+	assert.Equal("world\nfailed\n", output.Stderr())
+}
+
+func TestOutputLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	output := NewOutput()
+	output.append([]byte(strings.Repeat("a", OUTPUT_LIMIT)), nil)
+	output.append([]byte("b"), nil)
+	stdout := output.Stdout()
+	assert.Len(stdout, OUTPUT_LIMIT)
+	assert.True(strings.HasSuffix(stdout, "ab"))
+}
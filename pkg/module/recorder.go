@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	RECORD_TYPE_COMMAND  = "command"
+	RECORD_TYPE_UPLOAD   = "upload"
+	RECORD_TYPE_DOWNLOAD = "download"
+	RECORD_TYPE_ABORT    = "abort"
+
+	LOCAL_HOST = "localhost"
+)
+
+type (
+	Record struct {
+		Step    string `json:"step"`
+		Task    string `json:"task"`
+		Host    string `json:"host"`
+		Type    string `json:"type"`
+		Command string `json:"command,omitempty"`
+		Path    string `json:"path,omitempty"`
+		Content string `json:"content,omitempty"`
+		Error   string `json:"error,omitempty"`
+	}
+
+	// Recorder collects the commands and files of tasks instead of executing them (dry-run)
+	Recorder struct {
+		mutex   sync.Mutex
+		records []Record
+	}
+
+	// TaskRecorder records for one task, which executed in one host
+	TaskRecorder struct {
+		recorder *Recorder
+		step     string
+		task     string
+		host     string
+	}
+)
+
+func NewRecorder() *Recorder {
+	return &Recorder{records: []Record{}}
+}
+
+func (r *Recorder) ForTask(step, task, host string) *TaskRecorder {
+	return &TaskRecorder{
+		recorder: r,
+		step:     step,
+		task:     strings.Join(strings.Fields(task), " "),
+		host:     host,
+	}
+}
+
+func (r *Recorder) add(record Record) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.records = append(r.records, record)
+}
+
+func (r *Recorder) Records() []Record {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]Record{}, r.records...)
+}
+
+func (r *TaskRecorder) record(host, kind string) Record {
+	if len(host) == 0 {
+		host = r.host
+	}
+	return Record{Step: r.step, Task: r.task, Host: host, Type: kind}
+}
+
+func (r *TaskRecorder) Command(command string, options ExecOptions) {
+	host := ""
+	if options.ExecInLocal {
+		host = LOCAL_HOST
+	}
+	record := r.record(host, RECORD_TYPE_COMMAND)
+	record.Command = command
+	r.recorder.add(record)
+}
+
+// record the content which will be uploaded, it is read from local file
+func (r *TaskRecorder) Upload(localPath, remotePath string) {
+	record := r.record("", RECORD_TYPE_UPLOAD)
+	record.Path = remotePath
+	data, err := os.ReadFile(localPath)
+	if err != nil {
+		record.Error = err.Error()
+	} else if !utf8.Valid(data) {
+		record.Content = fmt.Sprintf("<binary, %d bytes>", len(data))
+	} else {
+		record.Content = string(data)
+	}
+	r.recorder.add(record)
+}
+
+// the remote file is not read in dry-run, an empty local file is left for the caller
+func (r *TaskRecorder) Download(remotePath, localPath string) error {
+	record := r.record("", RECORD_TYPE_DOWNLOAD)
+	record.Path = remotePath
+	r.recorder.add(record)
+	return os.WriteFile(localPath, []byte{}, 0644)
+}
+
+// the following steps of task depend on the real output, so stop the task
+func (r *TaskRecorder) Abort(err error) {
+	record := r.record("", RECORD_TYPE_ABORT)
+	record.Error = err.Error()
+	r.recorder.add(record)
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordModule(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := NewRecorder()
+	sshClient := NewOfflineSSHClient(SSHConfig{User: "dingo", Host: "10.0.0.1", Port: 22})
+	m := NewRecordModule(sshClient, recorder.ForTask("Pull Image", "host=10.0.0.1  image=dingofs", "10.0.0.1"))
+
+	out, err := m.DockerCli().PullImage("dingofs:latest").Execute(ExecOptions{
+		ExecWithSudo:   true,
+		ExecWithEngine: "docker",
+	})
+	assert.Nil(err)
+	assert.Equal("", out)
+	_, err = m.Shell().Mkdir("/tmp/dingo").Execute(ExecOptions{ExecInLocal: true})
+	assert.Nil(err)
+
+	localPath := filepath.Join(t.TempDir(), "mds.conf")
+	assert.Nil(os.WriteFile(localPath, []byte("listen=10.0.0.1:7400\n"), 0644))
+	assert.Nil(m.File().Upload(localPath, "/dingofs/conf/mds.conf"))
+	assert.Nil(m.File().Download("/dingofs/conf/mds.conf", localPath))
+	data, err := os.ReadFile(localPath)
+	assert.Nil(err)
+	assert.Empty(data)
+
+	records := recorder.Records()
+	assert.Len(records, 4)
+	assert.Equal(Record{Step: "Pull Image", Task: "host=10.0.0.1 image=dingofs", Host: "10.0.0.1",
+		Type: RECORD_TYPE_COMMAND, Command: "sudo docker pull  dingofs:latest"}, records[0])
+	assert.Equal(LOCAL_HOST, records[1].Host)
+	assert.Equal("mkdir  /tmp/dingo", records[1].Command)
+	assert.Equal(RECORD_TYPE_UPLOAD, records[2].Type)
+	assert.Equal("listen=10.0.0.1:7400\n", records[2].Content)
+	assert.Equal(RECORD_TYPE_DOWNLOAD, records[3].Type)
+}
+
+func TestRecordAbort(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := NewRecorder()
+	recorder.ForTask("Start Service", "host=10.0.0.1", "10.0.0.1").Abort(errors.New("no container"))
+	records := recorder.Records()
+	assert.Len(records, 1)
+	assert.Equal(RECORD_TYPE_ABORT, records[0].Type)
+	assert.Equal("no container", records[0].Error)
+}
@@ -54,14 +54,15 @@ const (
 	TEMPLATE_NETSTAT = "netstat {{.options}} '{{.filter}}'"
 
 	// kernel
-	TEMPLATE_WHOAMI   = "whoami"
-	TEMPLATE_DATE     = "date {{.options}} {{.format}}"
-	TEMPLATE_UNAME    = "uname {{.options}}"
-	TEMPLATE_MODPROBE = "modprobe {{.options}} {{.modulename}} {{.arguments}}"
-	TEMPLATE_MODINFO  = "modinfo {{.modulename}}"
-	TEMPLATE_PGREP    = "pgrep {{.options}} {{.pattern}}"
-	TEMPLATE_KILL     = "kill {{.options}} {{.pid}}"
-	TEMPLATE_GREP     = "grep {{.options}} {{.pattern}} {{.files}}"
+	TEMPLATE_WHOAMI    = "whoami"
+	TEMPLATE_DATE      = "date {{.options}} {{.format}}"
+	TEMPLATE_UNAME     = "uname {{.options}}"
+	TEMPLATE_MODPROBE  = "modprobe {{.options}} {{.modulename}} {{.arguments}}"
+	TEMPLATE_MODINFO   = "modinfo {{.modulename}}"
+	TEMPLATE_PGREP     = "pgrep {{.options}} {{.pattern}}"
+	TEMPLATE_KILL      = "kill {{.options}} {{.pid}}"
+	TEMPLATE_GREP      = "grep {{.options}} {{.pattern}} {{.files}}"
+	TEMPLATE_SYSTEMCTL = "systemctl {{.options}} {{.action}} {{.units}}"
 
 	// others
 	TEMPLATE_TAR  = "tar {{.options}} {{.file}}"
@@ -77,6 +78,8 @@ const (
 // TODO(P1): support command pipe
 type Shell struct {
 	sshClient *SSHClient
+	recorder  *TaskRecorder
+	output    *Output
 	options   []string
 	tmpl      *template.Template
 	data      map[string]interface{}
@@ -113,7 +116,7 @@ func (s *Shell) String() (string, error) {
 
 func (s *Shell) Execute(options ExecOptions) (string, error) {
 	s.data["options"] = strings.Join(s.options, " ")
-	return execCommand(s.sshClient, s.tmpl, s.data, options)
+	return execCommand(s.sshClient, s.recorder, s.output, s.tmpl, s.data, options)
 }
 
 // text
@@ -310,6 +313,13 @@ func (s *Shell) Grep(pattern string, files ...string) *Shell {
 	return s
 }
 
+func (s *Shell) Systemctl(action string, units ...string) *Shell {
+	s.tmpl = template.Must(template.New("systemctl").Parse(TEMPLATE_SYSTEMCTL))
+	s.data["action"] = action
+	s.data["units"] = strings.Join(units, " ")
+	return s
+}
+
 // other
 func (s *Shell) Tar(file string) *Shell {
 	s.tmpl = template.Must(template.New("tar").Parse(TEMPLATE_TAR))
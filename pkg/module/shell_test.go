@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellSystemctl(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := NewRecorder()
+	sshClient := NewOfflineSSHClient(SSHConfig{User: "dingo", Host: "10.0.0.1", Port: 22})
+	m := NewRecordModule(sshClient, recorder.ForTask("Start Service", "host=10.0.0.1 role=mds", "10.0.0.1"))
+
+	cmd, err := m.Shell().Systemctl("daemon-reload").String()
+	assert.Nil(err)
+	assert.Equal("systemctl  daemon-reload ", cmd)
+
+	_, err = m.Shell().Systemctl("disable", "dingofs-a.service", "dingofs-b.service").
+		AddOption("--now").
+		Execute(ExecOptions{ExecWithSudo: true})
+	assert.Nil(err)
+	records := recorder.Records()
+	assert.Len(records, 1)
+	assert.Equal("sudo systemctl --now disable dingofs-a.service dingofs-b.service", records[0].Command)
+}
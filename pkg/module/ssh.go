@@ -81,6 +81,11 @@ func (client *SSHClient) Config() SSHConfig {
 	return client.config
 }
 
+// NewOfflineSSHClient returns a client which holds the config only and never connects, used by dry-run
+func NewOfflineSSHClient(config SSHConfig) *SSHClient {
+	return &SSHClient{config: config}
+}
+
 func NewSSHClient(config SSHConfig) (*SSHClient, error) {
 	user := config.User
 	host := config.Host